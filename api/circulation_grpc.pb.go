@@ -0,0 +1,249 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v4.25.3
+// source: api/circulation.proto
+
+package api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CirculationService_CheckoutBook_FullMethodName = "/library.CirculationService/CheckoutBook"
+	CirculationService_ReturnBook_FullMethodName   = "/library.CirculationService/ReturnBook"
+	CirculationService_RenewLoan_FullMethodName    = "/library.CirculationService/RenewLoan"
+	CirculationService_ListLoans_FullMethodName    = "/library.CirculationService/ListLoans"
+)
+
+// CirculationServiceClient is the client API for CirculationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// The Circulation service lends books to members
+type CirculationServiceClient interface {
+	// Lend a copy of a book to a member, unless it is needed by members ahead
+	// of them in the hold queue
+	CheckoutBook(ctx context.Context, in *CheckoutBookRequest, opts ...grpc.CallOption) (*CheckoutBookResponse, error)
+	// Take back a book lent to a member
+	ReturnBook(ctx context.Context, in *ReturnBookRequest, opts ...grpc.CallOption) (*ReturnBookResponse, error)
+	// Extend the due date of a loan
+	RenewLoan(ctx context.Context, in *RenewLoanRequest, opts ...grpc.CallOption) (*RenewLoanResponse, error)
+	// List loans by member or book
+	ListLoans(ctx context.Context, in *ListLoansRequest, opts ...grpc.CallOption) (*ListLoansResponse, error)
+}
+
+type circulationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCirculationServiceClient(cc grpc.ClientConnInterface) CirculationServiceClient {
+	return &circulationServiceClient{cc}
+}
+
+func (c *circulationServiceClient) CheckoutBook(ctx context.Context, in *CheckoutBookRequest, opts ...grpc.CallOption) (*CheckoutBookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckoutBookResponse)
+	err := c.cc.Invoke(ctx, CirculationService_CheckoutBook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *circulationServiceClient) ReturnBook(ctx context.Context, in *ReturnBookRequest, opts ...grpc.CallOption) (*ReturnBookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReturnBookResponse)
+	err := c.cc.Invoke(ctx, CirculationService_ReturnBook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *circulationServiceClient) RenewLoan(ctx context.Context, in *RenewLoanRequest, opts ...grpc.CallOption) (*RenewLoanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenewLoanResponse)
+	err := c.cc.Invoke(ctx, CirculationService_RenewLoan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *circulationServiceClient) ListLoans(ctx context.Context, in *ListLoansRequest, opts ...grpc.CallOption) (*ListLoansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLoansResponse)
+	err := c.cc.Invoke(ctx, CirculationService_ListLoans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CirculationServiceServer is the server API for CirculationService service.
+// All implementations must embed UnimplementedCirculationServiceServer
+// for forward compatibility.
+//
+// The Circulation service lends books to members
+type CirculationServiceServer interface {
+	// Lend a copy of a book to a member, unless it is needed by members ahead
+	// of them in the hold queue
+	CheckoutBook(context.Context, *CheckoutBookRequest) (*CheckoutBookResponse, error)
+	// Take back a book lent to a member
+	ReturnBook(context.Context, *ReturnBookRequest) (*ReturnBookResponse, error)
+	// Extend the due date of a loan
+	RenewLoan(context.Context, *RenewLoanRequest) (*RenewLoanResponse, error)
+	// List loans by member or book
+	ListLoans(context.Context, *ListLoansRequest) (*ListLoansResponse, error)
+	mustEmbedUnimplementedCirculationServiceServer()
+}
+
+// UnimplementedCirculationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCirculationServiceServer struct{}
+
+func (UnimplementedCirculationServiceServer) CheckoutBook(context.Context, *CheckoutBookRequest) (*CheckoutBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckoutBook not implemented")
+}
+func (UnimplementedCirculationServiceServer) ReturnBook(context.Context, *ReturnBookRequest) (*ReturnBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReturnBook not implemented")
+}
+func (UnimplementedCirculationServiceServer) RenewLoan(context.Context, *RenewLoanRequest) (*RenewLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewLoan not implemented")
+}
+func (UnimplementedCirculationServiceServer) ListLoans(context.Context, *ListLoansRequest) (*ListLoansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLoans not implemented")
+}
+func (UnimplementedCirculationServiceServer) mustEmbedUnimplementedCirculationServiceServer() {}
+func (UnimplementedCirculationServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeCirculationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CirculationServiceServer will
+// result in compilation errors.
+type UnsafeCirculationServiceServer interface {
+	mustEmbedUnimplementedCirculationServiceServer()
+}
+
+func RegisterCirculationServiceServer(s grpc.ServiceRegistrar, srv CirculationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCirculationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CirculationService_ServiceDesc, srv)
+}
+
+func _CirculationService_CheckoutBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckoutBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CirculationServiceServer).CheckoutBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CirculationService_CheckoutBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CirculationServiceServer).CheckoutBook(ctx, req.(*CheckoutBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CirculationService_ReturnBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CirculationServiceServer).ReturnBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CirculationService_ReturnBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CirculationServiceServer).ReturnBook(ctx, req.(*ReturnBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CirculationService_RenewLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CirculationServiceServer).RenewLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CirculationService_RenewLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CirculationServiceServer).RenewLoan(ctx, req.(*RenewLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CirculationService_ListLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CirculationServiceServer).ListLoans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CirculationService_ListLoans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CirculationServiceServer).ListLoans(ctx, req.(*ListLoansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CirculationService_ServiceDesc is the grpc.ServiceDesc for CirculationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CirculationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "library.CirculationService",
+	HandlerType: (*CirculationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CheckoutBook",
+			Handler:    _CirculationService_CheckoutBook_Handler,
+		},
+		{
+			MethodName: "ReturnBook",
+			Handler:    _CirculationService_ReturnBook_Handler,
+		},
+		{
+			MethodName: "RenewLoan",
+			Handler:    _CirculationService_RenewLoan_Handler,
+		},
+		{
+			MethodName: "ListLoans",
+			Handler:    _CirculationService_ListLoans_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/circulation.proto",
+}
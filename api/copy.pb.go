@@ -0,0 +1,1151 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v4.25.3
+// source: api/copy.proto
+
+package api
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Physical state of the item
+type Copy_Condition int32
+
+const (
+	Copy_CONDITION_UNSPECIFIED Copy_Condition = 0 // Treated as GOOD
+	Copy_NEW                   Copy_Condition = 1
+	Copy_GOOD                  Copy_Condition = 2
+	Copy_FAIR                  Copy_Condition = 3
+	Copy_POOR                  Copy_Condition = 4
+	Copy_DAMAGED               Copy_Condition = 5
+)
+
+// Enum value maps for Copy_Condition.
+var (
+	Copy_Condition_name = map[int32]string{
+		0: "CONDITION_UNSPECIFIED",
+		1: "NEW",
+		2: "GOOD",
+		3: "FAIR",
+		4: "POOR",
+		5: "DAMAGED",
+	}
+	Copy_Condition_value = map[string]int32{
+		"CONDITION_UNSPECIFIED": 0,
+		"NEW":                   1,
+		"GOOD":                  2,
+		"FAIR":                  3,
+		"POOR":                  4,
+		"DAMAGED":               5,
+	}
+)
+
+func (x Copy_Condition) Enum() *Copy_Condition {
+	p := new(Copy_Condition)
+	*p = x
+	return p
+}
+
+func (x Copy_Condition) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Copy_Condition) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_copy_proto_enumTypes[0].Descriptor()
+}
+
+func (Copy_Condition) Type() protoreflect.EnumType {
+	return &file_api_copy_proto_enumTypes[0]
+}
+
+func (x Copy_Condition) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Copy_Condition.Descriptor instead.
+func (Copy_Condition) EnumDescriptor() ([]byte, []int) {
+	return file_api_copy_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// Whether the item can be lent
+type Copy_Status int32
+
+const (
+	Copy_STATUS_UNSPECIFIED Copy_Status = 0
+	Copy_AVAILABLE          Copy_Status = 1 // On the shelf
+	Copy_RETIRED            Copy_Status = 2 // Withdrawn from the collection, kept for history
+	Copy_ON_LOAN            Copy_Status = 3 // Checked out by a member
+	Copy_ON_HOLD            Copy_Status = 4 // Set aside for a member to pick up
+)
+
+// Enum value maps for Copy_Status.
+var (
+	Copy_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "AVAILABLE",
+		2: "RETIRED",
+		3: "ON_LOAN",
+		4: "ON_HOLD",
+	}
+	Copy_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"AVAILABLE":          1,
+		"RETIRED":            2,
+		"ON_LOAN":            3,
+		"ON_HOLD":            4,
+	}
+)
+
+func (x Copy_Status) Enum() *Copy_Status {
+	p := new(Copy_Status)
+	*p = x
+	return p
+}
+
+func (x Copy_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Copy_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_copy_proto_enumTypes[1].Descriptor()
+}
+
+func (Copy_Status) Type() protoreflect.EnumType {
+	return &file_api_copy_proto_enumTypes[1]
+}
+
+func (x Copy_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Copy_Status.Descriptor instead.
+func (Copy_Status) EnumDescriptor() ([]byte, []int) {
+	return file_api_copy_proto_rawDescGZIP(), []int{0, 1}
+}
+
+// Copy message represents one physical item of a book held by the library
+type Copy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                       // Unique identifier for the copy, assigned by the server
+	BookId  int32  `protobuf:"varint,2,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"` // ID of the book this is a copy of
+	Barcode string `protobuf:"bytes,3,opt,name=barcode,proto3" json:"barcode,omitempty"`              // Barcode printed on the item, unique
+	// Deprecated: Marked as deprecated in api/copy.proto.
+	LegacyBranch string         `protobuf:"bytes,4,opt,name=legacy_branch,json=legacyBranch,proto3" json:"legacy_branch,omitempty"`    // Branch code of copies stored before branch_id, converted to branch_id when the store is opened
+	Shelf        string         `protobuf:"bytes,5,opt,name=shelf,proto3" json:"shelf,omitempty"`                                      // Shelf location within the branch, e.g. "FIC-TOL-3"
+	Condition    Copy_Condition `protobuf:"varint,6,opt,name=condition,proto3,enum=library.Copy_Condition" json:"condition,omitempty"` // Physical condition
+	Status       Copy_Status    `protobuf:"varint,7,opt,name=status,proto3,enum=library.Copy_Status" json:"status,omitempty"`          // Set by the server
+	Revision     int64          `protobuf:"varint,8,opt,name=revision,proto3" json:"revision,omitempty"`                               // Set by the server, incremented on every change of the copy
+	BranchId     int32          `protobuf:"varint,9,opt,name=branch_id,json=branchId,proto3" json:"branch_id,omitempty"`               // ID of the Branch holding the item
+}
+
+func (x *Copy) Reset() {
+	*x = Copy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_copy_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Copy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Copy) ProtoMessage() {}
+
+func (x *Copy) ProtoReflect() protoreflect.Message {
+	mi := &file_api_copy_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Copy.ProtoReflect.Descriptor instead.
+func (*Copy) Descriptor() ([]byte, []int) {
+	return file_api_copy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Copy) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Copy) GetBookId() int32 {
+	if x != nil {
+		return x.BookId
+	}
+	return 0
+}
+
+func (x *Copy) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in api/copy.proto.
+func (x *Copy) GetLegacyBranch() string {
+	if x != nil {
+		return x.LegacyBranch
+	}
+	return ""
+}
+
+func (x *Copy) GetShelf() string {
+	if x != nil {
+		return x.Shelf
+	}
+	return ""
+}
+
+func (x *Copy) GetCondition() Copy_Condition {
+	if x != nil {
+		return x.Condition
+	}
+	return Copy_CONDITION_UNSPECIFIED
+}
+
+func (x *Copy) GetStatus() Copy_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Copy_STATUS_UNSPECIFIED
+}
+
+func (x *Copy) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *Copy) GetBranchId() int32 {
+	if x != nil {
+		return x.BranchId
+	}
+	return 0
+}
+
+// Request to add a copy of a book
+type AddCopyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Copy *Copy `protobuf:"bytes,1,opt,name=copy,proto3" json:"copy,omitempty"` // The copy with its book_id, barcode, branch_id and optional shelf and condition
+}
+
+func (x *AddCopyRequest) Reset() {
+	*x = AddCopyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_copy_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddCopyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCopyRequest) ProtoMessage() {}
+
+func (x *AddCopyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_copy_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCopyRequest.ProtoReflect.Descriptor instead.
+func (*AddCopyRequest) Descriptor() ([]byte, []int) {
+	return file_api_copy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddCopyRequest) GetCopy() *Copy {
+	if x != nil {
+		return x.Copy
+	}
+	return nil
+}
+
+// Response after adding a copy
+type AddCopyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Copy *Copy `protobuf:"bytes,1,opt,name=copy,proto3" json:"copy,omitempty"` // The new copy with ID and status
+}
+
+func (x *AddCopyResponse) Reset() {
+	*x = AddCopyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_copy_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddCopyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCopyResponse) ProtoMessage() {}
+
+func (x *AddCopyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_copy_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCopyResponse.ProtoReflect.Descriptor instead.
+func (*AddCopyResponse) Descriptor() ([]byte, []int) {
+	return file_api_copy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AddCopyResponse) GetCopy() *Copy {
+	if x != nil {
+		return x.Copy
+	}
+	return nil
+}
+
+// Request to get a copy by ID
+type GetCopyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the copy to retrieve
+}
+
+func (x *GetCopyRequest) Reset() {
+	*x = GetCopyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_copy_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCopyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCopyRequest) ProtoMessage() {}
+
+func (x *GetCopyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_copy_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCopyRequest.ProtoReflect.Descriptor instead.
+func (*GetCopyRequest) Descriptor() ([]byte, []int) {
+	return file_api_copy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetCopyRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Response with the copy details
+type GetCopyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Copy *Copy `protobuf:"bytes,1,opt,name=copy,proto3" json:"copy,omitempty"` // The retrieved copy
+}
+
+func (x *GetCopyResponse) Reset() {
+	*x = GetCopyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_copy_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCopyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCopyResponse) ProtoMessage() {}
+
+func (x *GetCopyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_copy_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCopyResponse.ProtoReflect.Descriptor instead.
+func (*GetCopyResponse) Descriptor() ([]byte, []int) {
+	return file_api_copy_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetCopyResponse) GetCopy() *Copy {
+	if x != nil {
+		return x.Copy
+	}
+	return nil
+}
+
+// Request to move a copy to another branch or shelf
+type MoveCopyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                             // ID of the copy to move
+	BranchId int32  `protobuf:"varint,5,opt,name=branch_id,json=branchId,proto3" json:"branch_id,omitempty"` // ID of the new branch
+	Shelf    string `protobuf:"bytes,3,opt,name=shelf,proto3" json:"shelf,omitempty"`                        // New shelf location
+	Revision int64  `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"`                 // If non-zero, only move the copy if this is its current revision
+}
+
+func (x *MoveCopyRequest) Reset() {
+	*x = MoveCopyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_copy_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveCopyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCopyRequest) ProtoMessage() {}
+
+func (x *MoveCopyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_copy_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCopyRequest.ProtoReflect.Descriptor instead.
+func (*MoveCopyRequest) Descriptor() ([]byte, []int) {
+	return file_api_copy_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MoveCopyRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MoveCopyRequest) GetBranchId() int32 {
+	if x != nil {
+		return x.BranchId
+	}
+	return 0
+}
+
+func (x *MoveCopyRequest) GetShelf() string {
+	if x != nil {
+		return x.Shelf
+	}
+	return ""
+}
+
+func (x *MoveCopyRequest) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+// Response after moving a copy
+type MoveCopyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Copy *Copy `protobuf:"bytes,1,opt,name=copy,proto3" json:"copy,omitempty"` // The moved copy
+}
+
+func (x *MoveCopyResponse) Reset() {
+	*x = MoveCopyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_copy_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveCopyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCopyResponse) ProtoMessage() {}
+
+func (x *MoveCopyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_copy_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCopyResponse.ProtoReflect.Descriptor instead.
+func (*MoveCopyResponse) Descriptor() ([]byte, []int) {
+	return file_api_copy_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MoveCopyResponse) GetCopy() *Copy {
+	if x != nil {
+		return x.Copy
+	}
+	return nil
+}
+
+// Request to withdraw a copy from the collection
+type RetireCopyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int32          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                           // ID of the copy to retire
+	Condition Copy_Condition `protobuf:"varint,2,opt,name=condition,proto3,enum=library.Copy_Condition" json:"condition,omitempty"` // Condition of the copy when retired, unchanged if unset
+	Revision  int64          `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`                               // If non-zero, only retire the copy if this is its current revision
+}
+
+func (x *RetireCopyRequest) Reset() {
+	*x = RetireCopyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_copy_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetireCopyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetireCopyRequest) ProtoMessage() {}
+
+func (x *RetireCopyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_copy_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetireCopyRequest.ProtoReflect.Descriptor instead.
+func (*RetireCopyRequest) Descriptor() ([]byte, []int) {
+	return file_api_copy_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RetireCopyRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RetireCopyRequest) GetCondition() Copy_Condition {
+	if x != nil {
+		return x.Condition
+	}
+	return Copy_CONDITION_UNSPECIFIED
+}
+
+func (x *RetireCopyRequest) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+// Response after retiring a copy
+type RetireCopyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Copy *Copy `protobuf:"bytes,1,opt,name=copy,proto3" json:"copy,omitempty"` // The retired copy
+}
+
+func (x *RetireCopyResponse) Reset() {
+	*x = RetireCopyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_copy_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetireCopyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetireCopyResponse) ProtoMessage() {}
+
+func (x *RetireCopyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_copy_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetireCopyResponse.ProtoReflect.Descriptor instead.
+func (*RetireCopyResponse) Descriptor() ([]byte, []int) {
+	return file_api_copy_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RetireCopyResponse) GetCopy() *Copy {
+	if x != nil {
+		return x.Copy
+	}
+	return nil
+}
+
+// Request to list copies in ID order, one page at a time
+type ListCopiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookId         int32  `protobuf:"varint,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`                         // Only copies of this book, if set
+	BranchId       int32  `protobuf:"varint,6,opt,name=branch_id,json=branchId,proto3" json:"branch_id,omitempty"`                   // Only copies held by this branch, if set
+	IncludeRetired bool   `protobuf:"varint,3,opt,name=include_retired,json=includeRetired,proto3" json:"include_retired,omitempty"` // Also list retired copies
+	PageSize       int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                   // Maximum number of copies to return, 50 if unset, at most 1000
+	PageToken      string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                 // next_page_token of the previous response, empty for the first page
+}
+
+func (x *ListCopiesRequest) Reset() {
+	*x = ListCopiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_copy_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCopiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCopiesRequest) ProtoMessage() {}
+
+func (x *ListCopiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_copy_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCopiesRequest.ProtoReflect.Descriptor instead.
+func (*ListCopiesRequest) Descriptor() ([]byte, []int) {
+	return file_api_copy_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListCopiesRequest) GetBookId() int32 {
+	if x != nil {
+		return x.BookId
+	}
+	return 0
+}
+
+func (x *ListCopiesRequest) GetBranchId() int32 {
+	if x != nil {
+		return x.BranchId
+	}
+	return 0
+}
+
+func (x *ListCopiesRequest) GetIncludeRetired() bool {
+	if x != nil {
+		return x.IncludeRetired
+	}
+	return false
+}
+
+func (x *ListCopiesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCopiesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Response containing a page of copies
+type ListCopiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Copies        []*Copy `protobuf:"bytes,1,rep,name=copies,proto3" json:"copies,omitempty"`                                      // Copies on this page
+	NextPageToken string  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Token for the next page, empty on the last page
+	TotalSize     int32   `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`              // Number of copies matching the request across all pages
+}
+
+func (x *ListCopiesResponse) Reset() {
+	*x = ListCopiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_copy_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCopiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCopiesResponse) ProtoMessage() {}
+
+func (x *ListCopiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_copy_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCopiesResponse.ProtoReflect.Descriptor instead.
+func (*ListCopiesResponse) Descriptor() ([]byte, []int) {
+	return file_api_copy_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListCopiesResponse) GetCopies() []*Copy {
+	if x != nil {
+		return x.Copies
+	}
+	return nil
+}
+
+func (x *ListCopiesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListCopiesResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+var File_api_copy_proto protoreflect.FileDescriptor
+
+var file_api_copy_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x70, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x07, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x22, 0xda, 0x03, 0x0a, 0x04, 0x43, 0x6f,
+	0x70, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62,
+	0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61,
+	0x72, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0d, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f,
+	0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01,
+	0x52, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+	0x68, 0x65, 0x6c, 0x66, 0x12, 0x35, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72,
+	0x79, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6c, 0x69,
+	0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f,
+	0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x49, 0x64, 0x22, 0x5a, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x19, 0x0a, 0x15, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x45,
+	0x57, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x47, 0x4f, 0x4f, 0x44, 0x10, 0x02, 0x12, 0x08, 0x0a,
+	0x04, 0x46, 0x41, 0x49, 0x52, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x4f, 0x52, 0x10,
+	0x04, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x41, 0x4d, 0x41, 0x47, 0x45, 0x44, 0x10, 0x05, 0x22, 0x56,
+	0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x0d, 0x0a, 0x09, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x01, 0x12,
+	0x0b, 0x0a, 0x07, 0x52, 0x45, 0x54, 0x49, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07,
+	0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x41, 0x4e, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x4e, 0x5f,
+	0x48, 0x4f, 0x4c, 0x44, 0x10, 0x04, 0x22, 0x33, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x70,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x04, 0x63, 0x6f, 0x70, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79,
+	0x2e, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x04, 0x63, 0x6f, 0x70, 0x79, 0x22, 0x34, 0x0a, 0x0f, 0x41,
+	0x64, 0x64, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21,
+	0x0a, 0x04, 0x63, 0x6f, 0x70, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6c,
+	0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x04, 0x63, 0x6f, 0x70,
+	0x79, 0x22, 0x20, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x34, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x63, 0x6f, 0x70, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x43,
+	0x6f, 0x70, 0x79, 0x52, 0x04, 0x63, 0x6f, 0x70, 0x79, 0x22, 0x76, 0x0a, 0x0f, 0x4d, 0x6f, 0x76,
+	0x65, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x65,
+	0x6c, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4a, 0x04, 0x08, 0x02, 0x10,
+	0x03, 0x22, 0x35, 0x0a, 0x10, 0x4d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x63, 0x6f, 0x70, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x43, 0x6f,
+	0x70, 0x79, 0x52, 0x04, 0x63, 0x6f, 0x70, 0x79, 0x22, 0x76, 0x0a, 0x11, 0x52, 0x65, 0x74, 0x69,
+	0x72, 0x65, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x35, 0x0a,
+	0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x2e,
+	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x37, 0x0a, 0x12, 0x52, 0x65, 0x74, 0x69, 0x72, 0x65, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x63, 0x6f, 0x70, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x43,
+	0x6f, 0x70, 0x79, 0x52, 0x04, 0x63, 0x6f, 0x70, 0x79, 0x22, 0xb4, 0x01, 0x0a, 0x11, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x70, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x74, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03,
+	0x22, 0x82, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x70, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x63, 0x6f, 0x70, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72,
+	0x79, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x06, 0x63, 0x6f, 0x70, 0x69, 0x65, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x53, 0x69, 0x7a, 0x65, 0x32, 0xd8, 0x02, 0x0a, 0x0b, 0x43, 0x6f, 0x70, 0x79, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x70, 0x79,
+	0x12, 0x17, 0x2e, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f,
+	0x70, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6c, 0x69, 0x62, 0x72,
+	0x61, 0x72, 0x79, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x70, 0x79, 0x12, 0x17,
+	0x2e, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x70, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72,
+	0x79, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3f, 0x0a, 0x08, 0x4d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x70, 0x79, 0x12, 0x18, 0x2e,
+	0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x70, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72,
+	0x79, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x52, 0x65, 0x74, 0x69, 0x72, 0x65, 0x43, 0x6f, 0x70, 0x79,
+	0x12, 0x1a, 0x2e, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x52, 0x65, 0x74, 0x69, 0x72,
+	0x65, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6c,
+	0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x52, 0x65, 0x74, 0x69, 0x72, 0x65, 0x43, 0x6f, 0x70,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6f, 0x70, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72,
+	0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x70, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x70, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x3f, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x48,
+	0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x2d, 0x53, 0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x2d, 0x6f, 0x66,
+	0x2d, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x2d, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c,
+	0x6f, 0x67, 0x69, 0x65, 0x73, 0x2f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2f, 0x61, 0x70,
+	0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_copy_proto_rawDescOnce sync.Once
+	file_api_copy_proto_rawDescData = file_api_copy_proto_rawDesc
+)
+
+func file_api_copy_proto_rawDescGZIP() []byte {
+	file_api_copy_proto_rawDescOnce.Do(func() {
+		file_api_copy_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_copy_proto_rawDescData)
+	})
+	return file_api_copy_proto_rawDescData
+}
+
+var file_api_copy_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_copy_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_api_copy_proto_goTypes = []any{
+	(Copy_Condition)(0),        // 0: library.Copy.Condition
+	(Copy_Status)(0),           // 1: library.Copy.Status
+	(*Copy)(nil),               // 2: library.Copy
+	(*AddCopyRequest)(nil),     // 3: library.AddCopyRequest
+	(*AddCopyResponse)(nil),    // 4: library.AddCopyResponse
+	(*GetCopyRequest)(nil),     // 5: library.GetCopyRequest
+	(*GetCopyResponse)(nil),    // 6: library.GetCopyResponse
+	(*MoveCopyRequest)(nil),    // 7: library.MoveCopyRequest
+	(*MoveCopyResponse)(nil),   // 8: library.MoveCopyResponse
+	(*RetireCopyRequest)(nil),  // 9: library.RetireCopyRequest
+	(*RetireCopyResponse)(nil), // 10: library.RetireCopyResponse
+	(*ListCopiesRequest)(nil),  // 11: library.ListCopiesRequest
+	(*ListCopiesResponse)(nil), // 12: library.ListCopiesResponse
+}
+var file_api_copy_proto_depIdxs = []int32{
+	0,  // 0: library.Copy.condition:type_name -> library.Copy.Condition
+	1,  // 1: library.Copy.status:type_name -> library.Copy.Status
+	2,  // 2: library.AddCopyRequest.copy:type_name -> library.Copy
+	2,  // 3: library.AddCopyResponse.copy:type_name -> library.Copy
+	2,  // 4: library.GetCopyResponse.copy:type_name -> library.Copy
+	2,  // 5: library.MoveCopyResponse.copy:type_name -> library.Copy
+	0,  // 6: library.RetireCopyRequest.condition:type_name -> library.Copy.Condition
+	2,  // 7: library.RetireCopyResponse.copy:type_name -> library.Copy
+	2,  // 8: library.ListCopiesResponse.copies:type_name -> library.Copy
+	3,  // 9: library.CopyService.AddCopy:input_type -> library.AddCopyRequest
+	5,  // 10: library.CopyService.GetCopy:input_type -> library.GetCopyRequest
+	7,  // 11: library.CopyService.MoveCopy:input_type -> library.MoveCopyRequest
+	9,  // 12: library.CopyService.RetireCopy:input_type -> library.RetireCopyRequest
+	11, // 13: library.CopyService.ListCopies:input_type -> library.ListCopiesRequest
+	4,  // 14: library.CopyService.AddCopy:output_type -> library.AddCopyResponse
+	6,  // 15: library.CopyService.GetCopy:output_type -> library.GetCopyResponse
+	8,  // 16: library.CopyService.MoveCopy:output_type -> library.MoveCopyResponse
+	10, // 17: library.CopyService.RetireCopy:output_type -> library.RetireCopyResponse
+	12, // 18: library.CopyService.ListCopies:output_type -> library.ListCopiesResponse
+	14, // [14:19] is the sub-list for method output_type
+	9,  // [9:14] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_api_copy_proto_init() }
+func file_api_copy_proto_init() {
+	if File_api_copy_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_copy_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Copy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_copy_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*AddCopyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_copy_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*AddCopyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_copy_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCopyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_copy_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCopyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_copy_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*MoveCopyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_copy_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*MoveCopyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_copy_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*RetireCopyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_copy_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*RetireCopyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_copy_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*ListCopiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_copy_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*ListCopiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_copy_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_copy_proto_goTypes,
+		DependencyIndexes: file_api_copy_proto_depIdxs,
+		EnumInfos:         file_api_copy_proto_enumTypes,
+		MessageInfos:      file_api_copy_proto_msgTypes,
+	}.Build()
+	File_api_copy_proto = out.File
+	file_api_copy_proto_rawDesc = nil
+	file_api_copy_proto_goTypes = nil
+	file_api_copy_proto_depIdxs = nil
+}
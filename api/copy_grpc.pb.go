@@ -0,0 +1,287 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v4.25.3
+// source: api/copy.proto
+
+package api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CopyService_AddCopy_FullMethodName    = "/library.CopyService/AddCopy"
+	CopyService_GetCopy_FullMethodName    = "/library.CopyService/GetCopy"
+	CopyService_MoveCopy_FullMethodName   = "/library.CopyService/MoveCopy"
+	CopyService_RetireCopy_FullMethodName = "/library.CopyService/RetireCopy"
+	CopyService_ListCopies_FullMethodName = "/library.CopyService/ListCopies"
+)
+
+// CopyServiceClient is the client API for CopyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// The Copy service manages the physical items of each book
+type CopyServiceClient interface {
+	// Add a copy of a book to the collection
+	AddCopy(ctx context.Context, in *AddCopyRequest, opts ...grpc.CallOption) (*AddCopyResponse, error)
+	// Get details of a copy by ID
+	GetCopy(ctx context.Context, in *GetCopyRequest, opts ...grpc.CallOption) (*GetCopyResponse, error)
+	// Move a copy to another branch or shelf
+	MoveCopy(ctx context.Context, in *MoveCopyRequest, opts ...grpc.CallOption) (*MoveCopyResponse, error)
+	// Withdraw a copy from the collection
+	RetireCopy(ctx context.Context, in *RetireCopyRequest, opts ...grpc.CallOption) (*RetireCopyResponse, error)
+	// List copies, optionally of one book or branch
+	ListCopies(ctx context.Context, in *ListCopiesRequest, opts ...grpc.CallOption) (*ListCopiesResponse, error)
+}
+
+type copyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCopyServiceClient(cc grpc.ClientConnInterface) CopyServiceClient {
+	return &copyServiceClient{cc}
+}
+
+func (c *copyServiceClient) AddCopy(ctx context.Context, in *AddCopyRequest, opts ...grpc.CallOption) (*AddCopyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddCopyResponse)
+	err := c.cc.Invoke(ctx, CopyService_AddCopy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *copyServiceClient) GetCopy(ctx context.Context, in *GetCopyRequest, opts ...grpc.CallOption) (*GetCopyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCopyResponse)
+	err := c.cc.Invoke(ctx, CopyService_GetCopy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *copyServiceClient) MoveCopy(ctx context.Context, in *MoveCopyRequest, opts ...grpc.CallOption) (*MoveCopyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveCopyResponse)
+	err := c.cc.Invoke(ctx, CopyService_MoveCopy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *copyServiceClient) RetireCopy(ctx context.Context, in *RetireCopyRequest, opts ...grpc.CallOption) (*RetireCopyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetireCopyResponse)
+	err := c.cc.Invoke(ctx, CopyService_RetireCopy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *copyServiceClient) ListCopies(ctx context.Context, in *ListCopiesRequest, opts ...grpc.CallOption) (*ListCopiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCopiesResponse)
+	err := c.cc.Invoke(ctx, CopyService_ListCopies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CopyServiceServer is the server API for CopyService service.
+// All implementations must embed UnimplementedCopyServiceServer
+// for forward compatibility.
+//
+// The Copy service manages the physical items of each book
+type CopyServiceServer interface {
+	// Add a copy of a book to the collection
+	AddCopy(context.Context, *AddCopyRequest) (*AddCopyResponse, error)
+	// Get details of a copy by ID
+	GetCopy(context.Context, *GetCopyRequest) (*GetCopyResponse, error)
+	// Move a copy to another branch or shelf
+	MoveCopy(context.Context, *MoveCopyRequest) (*MoveCopyResponse, error)
+	// Withdraw a copy from the collection
+	RetireCopy(context.Context, *RetireCopyRequest) (*RetireCopyResponse, error)
+	// List copies, optionally of one book or branch
+	ListCopies(context.Context, *ListCopiesRequest) (*ListCopiesResponse, error)
+	mustEmbedUnimplementedCopyServiceServer()
+}
+
+// UnimplementedCopyServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCopyServiceServer struct{}
+
+func (UnimplementedCopyServiceServer) AddCopy(context.Context, *AddCopyRequest) (*AddCopyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCopy not implemented")
+}
+func (UnimplementedCopyServiceServer) GetCopy(context.Context, *GetCopyRequest) (*GetCopyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCopy not implemented")
+}
+func (UnimplementedCopyServiceServer) MoveCopy(context.Context, *MoveCopyRequest) (*MoveCopyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCopy not implemented")
+}
+func (UnimplementedCopyServiceServer) RetireCopy(context.Context, *RetireCopyRequest) (*RetireCopyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetireCopy not implemented")
+}
+func (UnimplementedCopyServiceServer) ListCopies(context.Context, *ListCopiesRequest) (*ListCopiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCopies not implemented")
+}
+func (UnimplementedCopyServiceServer) mustEmbedUnimplementedCopyServiceServer() {}
+func (UnimplementedCopyServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeCopyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CopyServiceServer will
+// result in compilation errors.
+type UnsafeCopyServiceServer interface {
+	mustEmbedUnimplementedCopyServiceServer()
+}
+
+func RegisterCopyServiceServer(s grpc.ServiceRegistrar, srv CopyServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCopyServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CopyService_ServiceDesc, srv)
+}
+
+func _CopyService_AddCopy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCopyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CopyServiceServer).AddCopy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CopyService_AddCopy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CopyServiceServer).AddCopy(ctx, req.(*AddCopyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CopyService_GetCopy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCopyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CopyServiceServer).GetCopy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CopyService_GetCopy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CopyServiceServer).GetCopy(ctx, req.(*GetCopyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CopyService_MoveCopy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCopyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CopyServiceServer).MoveCopy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CopyService_MoveCopy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CopyServiceServer).MoveCopy(ctx, req.(*MoveCopyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CopyService_RetireCopy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetireCopyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CopyServiceServer).RetireCopy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CopyService_RetireCopy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CopyServiceServer).RetireCopy(ctx, req.(*RetireCopyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CopyService_ListCopies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCopiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CopyServiceServer).ListCopies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CopyService_ListCopies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CopyServiceServer).ListCopies(ctx, req.(*ListCopiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CopyService_ServiceDesc is the grpc.ServiceDesc for CopyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CopyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "library.CopyService",
+	HandlerType: (*CopyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddCopy",
+			Handler:    _CopyService_AddCopy_Handler,
+		},
+		{
+			MethodName: "GetCopy",
+			Handler:    _CopyService_GetCopy_Handler,
+		},
+		{
+			MethodName: "MoveCopy",
+			Handler:    _CopyService_MoveCopy_Handler,
+		},
+		{
+			MethodName: "RetireCopy",
+			Handler:    _CopyService_RetireCopy_Handler,
+		},
+		{
+			MethodName: "ListCopies",
+			Handler:    _CopyService_ListCopies_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/copy.proto",
+}
@@ -0,0 +1,407 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v4.25.3
+// source: api/fee.proto
+
+package api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	FeeService_ChargeOverdue_FullMethodName     = "/library.FeeService/ChargeOverdue"
+	FeeService_ChargeLostItem_FullMethodName    = "/library.FeeService/ChargeLostItem"
+	FeeService_ChargeFee_FullMethodName         = "/library.FeeService/ChargeFee"
+	FeeService_WaiveCharge_FullMethodName       = "/library.FeeService/WaiveCharge"
+	FeeService_RecordPayment_FullMethodName     = "/library.FeeService/RecordPayment"
+	FeeService_RefundPayment_FullMethodName     = "/library.FeeService/RefundPayment"
+	FeeService_GetBalance_FullMethodName        = "/library.FeeService/GetBalance"
+	FeeService_ListLedgerEntries_FullMethodName = "/library.FeeService/ListLedgerEntries"
+)
+
+// FeeServiceClient is the client API for FeeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// The Fee service keeps the fee ledger of every member
+type FeeServiceClient interface {
+	// Charge the overdue fine of a loan
+	ChargeOverdue(ctx context.Context, in *ChargeOverdueRequest, opts ...grpc.CallOption) (*ChargeOverdueResponse, error)
+	// Charge the replacement cost of a copy lost on loan
+	ChargeLostItem(ctx context.Context, in *ChargeLostItemRequest, opts ...grpc.CallOption) (*ChargeLostItemResponse, error)
+	// Charge a manual fee
+	ChargeFee(ctx context.Context, in *ChargeFeeRequest, opts ...grpc.CallOption) (*ChargeFeeResponse, error)
+	// Waive part or all of a charge
+	WaiveCharge(ctx context.Context, in *WaiveChargeRequest, opts ...grpc.CallOption) (*WaiveChargeResponse, error)
+	// Record a payment from a member
+	RecordPayment(ctx context.Context, in *RecordPaymentRequest, opts ...grpc.CallOption) (*RecordPaymentResponse, error)
+	// Refund part or all of a payment
+	RefundPayment(ctx context.Context, in *RefundPaymentRequest, opts ...grpc.CallOption) (*RefundPaymentResponse, error)
+	// Get the balance of a member and whether it blocks borrowing
+	GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceResponse, error)
+	// List the ledger entries of a member
+	ListLedgerEntries(ctx context.Context, in *ListLedgerEntriesRequest, opts ...grpc.CallOption) (*ListLedgerEntriesResponse, error)
+}
+
+type feeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFeeServiceClient(cc grpc.ClientConnInterface) FeeServiceClient {
+	return &feeServiceClient{cc}
+}
+
+func (c *feeServiceClient) ChargeOverdue(ctx context.Context, in *ChargeOverdueRequest, opts ...grpc.CallOption) (*ChargeOverdueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChargeOverdueResponse)
+	err := c.cc.Invoke(ctx, FeeService_ChargeOverdue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feeServiceClient) ChargeLostItem(ctx context.Context, in *ChargeLostItemRequest, opts ...grpc.CallOption) (*ChargeLostItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChargeLostItemResponse)
+	err := c.cc.Invoke(ctx, FeeService_ChargeLostItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feeServiceClient) ChargeFee(ctx context.Context, in *ChargeFeeRequest, opts ...grpc.CallOption) (*ChargeFeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChargeFeeResponse)
+	err := c.cc.Invoke(ctx, FeeService_ChargeFee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feeServiceClient) WaiveCharge(ctx context.Context, in *WaiveChargeRequest, opts ...grpc.CallOption) (*WaiveChargeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WaiveChargeResponse)
+	err := c.cc.Invoke(ctx, FeeService_WaiveCharge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feeServiceClient) RecordPayment(ctx context.Context, in *RecordPaymentRequest, opts ...grpc.CallOption) (*RecordPaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordPaymentResponse)
+	err := c.cc.Invoke(ctx, FeeService_RecordPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feeServiceClient) RefundPayment(ctx context.Context, in *RefundPaymentRequest, opts ...grpc.CallOption) (*RefundPaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundPaymentResponse)
+	err := c.cc.Invoke(ctx, FeeService_RefundPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feeServiceClient) GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBalanceResponse)
+	err := c.cc.Invoke(ctx, FeeService_GetBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feeServiceClient) ListLedgerEntries(ctx context.Context, in *ListLedgerEntriesRequest, opts ...grpc.CallOption) (*ListLedgerEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLedgerEntriesResponse)
+	err := c.cc.Invoke(ctx, FeeService_ListLedgerEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FeeServiceServer is the server API for FeeService service.
+// All implementations must embed UnimplementedFeeServiceServer
+// for forward compatibility.
+//
+// The Fee service keeps the fee ledger of every member
+type FeeServiceServer interface {
+	// Charge the overdue fine of a loan
+	ChargeOverdue(context.Context, *ChargeOverdueRequest) (*ChargeOverdueResponse, error)
+	// Charge the replacement cost of a copy lost on loan
+	ChargeLostItem(context.Context, *ChargeLostItemRequest) (*ChargeLostItemResponse, error)
+	// Charge a manual fee
+	ChargeFee(context.Context, *ChargeFeeRequest) (*ChargeFeeResponse, error)
+	// Waive part or all of a charge
+	WaiveCharge(context.Context, *WaiveChargeRequest) (*WaiveChargeResponse, error)
+	// Record a payment from a member
+	RecordPayment(context.Context, *RecordPaymentRequest) (*RecordPaymentResponse, error)
+	// Refund part or all of a payment
+	RefundPayment(context.Context, *RefundPaymentRequest) (*RefundPaymentResponse, error)
+	// Get the balance of a member and whether it blocks borrowing
+	GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error)
+	// List the ledger entries of a member
+	ListLedgerEntries(context.Context, *ListLedgerEntriesRequest) (*ListLedgerEntriesResponse, error)
+	mustEmbedUnimplementedFeeServiceServer()
+}
+
+// UnimplementedFeeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFeeServiceServer struct{}
+
+func (UnimplementedFeeServiceServer) ChargeOverdue(context.Context, *ChargeOverdueRequest) (*ChargeOverdueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChargeOverdue not implemented")
+}
+func (UnimplementedFeeServiceServer) ChargeLostItem(context.Context, *ChargeLostItemRequest) (*ChargeLostItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChargeLostItem not implemented")
+}
+func (UnimplementedFeeServiceServer) ChargeFee(context.Context, *ChargeFeeRequest) (*ChargeFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChargeFee not implemented")
+}
+func (UnimplementedFeeServiceServer) WaiveCharge(context.Context, *WaiveChargeRequest) (*WaiveChargeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaiveCharge not implemented")
+}
+func (UnimplementedFeeServiceServer) RecordPayment(context.Context, *RecordPaymentRequest) (*RecordPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordPayment not implemented")
+}
+func (UnimplementedFeeServiceServer) RefundPayment(context.Context, *RefundPaymentRequest) (*RefundPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundPayment not implemented")
+}
+func (UnimplementedFeeServiceServer) GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalance not implemented")
+}
+func (UnimplementedFeeServiceServer) ListLedgerEntries(context.Context, *ListLedgerEntriesRequest) (*ListLedgerEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLedgerEntries not implemented")
+}
+func (UnimplementedFeeServiceServer) mustEmbedUnimplementedFeeServiceServer() {}
+func (UnimplementedFeeServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeFeeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FeeServiceServer will
+// result in compilation errors.
+type UnsafeFeeServiceServer interface {
+	mustEmbedUnimplementedFeeServiceServer()
+}
+
+func RegisterFeeServiceServer(s grpc.ServiceRegistrar, srv FeeServiceServer) {
+	// If the following call pancis, it indicates UnimplementedFeeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FeeService_ServiceDesc, srv)
+}
+
+func _FeeService_ChargeOverdue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChargeOverdueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeServiceServer).ChargeOverdue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeeService_ChargeOverdue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeServiceServer).ChargeOverdue(ctx, req.(*ChargeOverdueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeeService_ChargeLostItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChargeLostItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeServiceServer).ChargeLostItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeeService_ChargeLostItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeServiceServer).ChargeLostItem(ctx, req.(*ChargeLostItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeeService_ChargeFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChargeFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeServiceServer).ChargeFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeeService_ChargeFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeServiceServer).ChargeFee(ctx, req.(*ChargeFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeeService_WaiveCharge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaiveChargeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeServiceServer).WaiveCharge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeeService_WaiveCharge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeServiceServer).WaiveCharge(ctx, req.(*WaiveChargeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeeService_RecordPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeServiceServer).RecordPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeeService_RecordPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeServiceServer).RecordPayment(ctx, req.(*RecordPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeeService_RefundPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeServiceServer).RefundPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeeService_RefundPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeServiceServer).RefundPayment(ctx, req.(*RefundPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeeService_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeServiceServer).GetBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeeService_GetBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeServiceServer).GetBalance(ctx, req.(*GetBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeeService_ListLedgerEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLedgerEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeServiceServer).ListLedgerEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeeService_ListLedgerEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeServiceServer).ListLedgerEntries(ctx, req.(*ListLedgerEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FeeService_ServiceDesc is the grpc.ServiceDesc for FeeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FeeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "library.FeeService",
+	HandlerType: (*FeeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ChargeOverdue",
+			Handler:    _FeeService_ChargeOverdue_Handler,
+		},
+		{
+			MethodName: "ChargeLostItem",
+			Handler:    _FeeService_ChargeLostItem_Handler,
+		},
+		{
+			MethodName: "ChargeFee",
+			Handler:    _FeeService_ChargeFee_Handler,
+		},
+		{
+			MethodName: "WaiveCharge",
+			Handler:    _FeeService_WaiveCharge_Handler,
+		},
+		{
+			MethodName: "RecordPayment",
+			Handler:    _FeeService_RecordPayment_Handler,
+		},
+		{
+			MethodName: "RefundPayment",
+			Handler:    _FeeService_RefundPayment_Handler,
+		},
+		{
+			MethodName: "GetBalance",
+			Handler:    _FeeService_GetBalance_Handler,
+		},
+		{
+			MethodName: "ListLedgerEntries",
+			Handler:    _FeeService_ListLedgerEntries_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/fee.proto",
+}
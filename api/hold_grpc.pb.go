@@ -0,0 +1,247 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v4.25.3
+// source: api/hold.proto
+
+package api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	HoldService_PlaceHold_FullMethodName       = "/library.HoldService/PlaceHold"
+	HoldService_CancelHold_FullMethodName      = "/library.HoldService/CancelHold"
+	HoldService_ListHolds_FullMethodName       = "/library.HoldService/ListHolds"
+	HoldService_FulfillNextHold_FullMethodName = "/library.HoldService/FulfillNextHold"
+)
+
+// HoldServiceClient is the client API for HoldService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// The Hold service manages the reservation queue of every book
+type HoldServiceClient interface {
+	// Join the queue of a book
+	PlaceHold(ctx context.Context, in *PlaceHoldRequest, opts ...grpc.CallOption) (*PlaceHoldResponse, error)
+	// Leave the queue of a book, releasing any copy set aside
+	CancelHold(ctx context.Context, in *CancelHoldRequest, opts ...grpc.CallOption) (*CancelHoldResponse, error)
+	// List holds by book or member with their queue positions
+	ListHolds(ctx context.Context, in *ListHoldsRequest, opts ...grpc.CallOption) (*ListHoldsResponse, error)
+	// Set a copy aside for the first member in the queue of a book
+	FulfillNextHold(ctx context.Context, in *FulfillNextHoldRequest, opts ...grpc.CallOption) (*FulfillNextHoldResponse, error)
+}
+
+type holdServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHoldServiceClient(cc grpc.ClientConnInterface) HoldServiceClient {
+	return &holdServiceClient{cc}
+}
+
+func (c *holdServiceClient) PlaceHold(ctx context.Context, in *PlaceHoldRequest, opts ...grpc.CallOption) (*PlaceHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlaceHoldResponse)
+	err := c.cc.Invoke(ctx, HoldService_PlaceHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *holdServiceClient) CancelHold(ctx context.Context, in *CancelHoldRequest, opts ...grpc.CallOption) (*CancelHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelHoldResponse)
+	err := c.cc.Invoke(ctx, HoldService_CancelHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *holdServiceClient) ListHolds(ctx context.Context, in *ListHoldsRequest, opts ...grpc.CallOption) (*ListHoldsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHoldsResponse)
+	err := c.cc.Invoke(ctx, HoldService_ListHolds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *holdServiceClient) FulfillNextHold(ctx context.Context, in *FulfillNextHoldRequest, opts ...grpc.CallOption) (*FulfillNextHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FulfillNextHoldResponse)
+	err := c.cc.Invoke(ctx, HoldService_FulfillNextHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HoldServiceServer is the server API for HoldService service.
+// All implementations must embed UnimplementedHoldServiceServer
+// for forward compatibility.
+//
+// The Hold service manages the reservation queue of every book
+type HoldServiceServer interface {
+	// Join the queue of a book
+	PlaceHold(context.Context, *PlaceHoldRequest) (*PlaceHoldResponse, error)
+	// Leave the queue of a book, releasing any copy set aside
+	CancelHold(context.Context, *CancelHoldRequest) (*CancelHoldResponse, error)
+	// List holds by book or member with their queue positions
+	ListHolds(context.Context, *ListHoldsRequest) (*ListHoldsResponse, error)
+	// Set a copy aside for the first member in the queue of a book
+	FulfillNextHold(context.Context, *FulfillNextHoldRequest) (*FulfillNextHoldResponse, error)
+	mustEmbedUnimplementedHoldServiceServer()
+}
+
+// UnimplementedHoldServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedHoldServiceServer struct{}
+
+func (UnimplementedHoldServiceServer) PlaceHold(context.Context, *PlaceHoldRequest) (*PlaceHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceHold not implemented")
+}
+func (UnimplementedHoldServiceServer) CancelHold(context.Context, *CancelHoldRequest) (*CancelHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelHold not implemented")
+}
+func (UnimplementedHoldServiceServer) ListHolds(context.Context, *ListHoldsRequest) (*ListHoldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHolds not implemented")
+}
+func (UnimplementedHoldServiceServer) FulfillNextHold(context.Context, *FulfillNextHoldRequest) (*FulfillNextHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfillNextHold not implemented")
+}
+func (UnimplementedHoldServiceServer) mustEmbedUnimplementedHoldServiceServer() {}
+func (UnimplementedHoldServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeHoldServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HoldServiceServer will
+// result in compilation errors.
+type UnsafeHoldServiceServer interface {
+	mustEmbedUnimplementedHoldServiceServer()
+}
+
+func RegisterHoldServiceServer(s grpc.ServiceRegistrar, srv HoldServiceServer) {
+	// If the following call pancis, it indicates UnimplementedHoldServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&HoldService_ServiceDesc, srv)
+}
+
+func _HoldService_PlaceHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HoldServiceServer).PlaceHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HoldService_PlaceHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HoldServiceServer).PlaceHold(ctx, req.(*PlaceHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HoldService_CancelHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HoldServiceServer).CancelHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HoldService_CancelHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HoldServiceServer).CancelHold(ctx, req.(*CancelHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HoldService_ListHolds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHoldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HoldServiceServer).ListHolds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HoldService_ListHolds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HoldServiceServer).ListHolds(ctx, req.(*ListHoldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HoldService_FulfillNextHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FulfillNextHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HoldServiceServer).FulfillNextHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HoldService_FulfillNextHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HoldServiceServer).FulfillNextHold(ctx, req.(*FulfillNextHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HoldService_ServiceDesc is the grpc.ServiceDesc for HoldService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HoldService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "library.HoldService",
+	HandlerType: (*HoldServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PlaceHold",
+			Handler:    _HoldService_PlaceHold_Handler,
+		},
+		{
+			MethodName: "CancelHold",
+			Handler:    _HoldService_CancelHold_Handler,
+		},
+		{
+			MethodName: "ListHolds",
+			Handler:    _HoldService_ListHolds_Handler,
+		},
+		{
+			MethodName: "FulfillNextHold",
+			Handler:    _HoldService_FulfillNextHold_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/hold.proto",
+}
@@ -19,11 +19,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LibraryService_CreateBook_FullMethodName = "/library.LibraryService/CreateBook"
-	LibraryService_GetBook_FullMethodName    = "/library.LibraryService/GetBook"
-	LibraryService_UpdateBook_FullMethodName = "/library.LibraryService/UpdateBook"
-	LibraryService_DeleteBook_FullMethodName = "/library.LibraryService/DeleteBook"
-	LibraryService_ListBooks_FullMethodName  = "/library.LibraryService/ListBooks"
+	LibraryService_CreateBook_FullMethodName       = "/library.LibraryService/CreateBook"
+	LibraryService_GetBook_FullMethodName          = "/library.LibraryService/GetBook"
+	LibraryService_UpdateBook_FullMethodName       = "/library.LibraryService/UpdateBook"
+	LibraryService_PatchBook_FullMethodName        = "/library.LibraryService/PatchBook"
+	LibraryService_DeleteBook_FullMethodName       = "/library.LibraryService/DeleteBook"
+	LibraryService_ListBooks_FullMethodName        = "/library.LibraryService/ListBooks"
+	LibraryService_StreamBooks_FullMethodName      = "/library.LibraryService/StreamBooks"
+	LibraryService_ImportBooks_FullMethodName      = "/library.LibraryService/ImportBooks"
+	LibraryService_WatchBooks_FullMethodName       = "/library.LibraryService/WatchBooks"
+	LibraryService_BatchCreateBooks_FullMethodName = "/library.LibraryService/BatchCreateBooks"
+	LibraryService_BatchUpdateBooks_FullMethodName = "/library.LibraryService/BatchUpdateBooks"
+	LibraryService_BatchDeleteBooks_FullMethodName = "/library.LibraryService/BatchDeleteBooks"
+	LibraryService_BatchGetBooks_FullMethodName    = "/library.LibraryService/BatchGetBooks"
+	LibraryService_SearchBooks_FullMethodName      = "/library.LibraryService/SearchBooks"
 )
 
 // LibraryServiceClient is the client API for LibraryService service.
@@ -38,10 +47,28 @@ type LibraryServiceClient interface {
 	GetBook(ctx context.Context, in *GetBookRequest, opts ...grpc.CallOption) (*GetBookResponse, error)
 	// Update an existing book
 	UpdateBook(ctx context.Context, in *UpdateBookRequest, opts ...grpc.CallOption) (*UpdateBookResponse, error)
+	// Update only the fields of a book listed in a field mask
+	PatchBook(ctx context.Context, in *PatchBookRequest, opts ...grpc.CallOption) (*PatchBookResponse, error)
 	// Delete a book by ID
 	DeleteBook(ctx context.Context, in *DeleteBookRequest, opts ...grpc.CallOption) (*DeleteBookResponse, error)
-	// List all books
+	// List books with pagination, filtering and ordering
 	ListBooks(ctx context.Context, in *ListBooksRequest, opts ...grpc.CallOption) (*ListBooksResponse, error)
+	// Stream all matching books in chunks, for catalogs too large for one message
+	StreamBooks(ctx context.Context, in *StreamBooksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamBooksResponse], error)
+	// Import a stream of books, creating or updating them by ISBN
+	ImportBooks(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[Book, ImportBooksResponse], error)
+	// Follow changes to the catalog as they happen
+	WatchBooks(ctx context.Context, in *WatchBooksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BookEvent], error)
+	// Create several books in one transaction
+	BatchCreateBooks(ctx context.Context, in *BatchCreateBooksRequest, opts ...grpc.CallOption) (*BatchCreateBooksResponse, error)
+	// Update several books in one transaction
+	BatchUpdateBooks(ctx context.Context, in *BatchUpdateBooksRequest, opts ...grpc.CallOption) (*BatchUpdateBooksResponse, error)
+	// Delete several books in one transaction
+	BatchDeleteBooks(ctx context.Context, in *BatchDeleteBooksRequest, opts ...grpc.CallOption) (*BatchDeleteBooksResponse, error)
+	// Get several books from one consistent view of the catalog
+	BatchGetBooks(ctx context.Context, in *BatchGetBooksRequest, opts ...grpc.CallOption) (*BatchGetBooksResponse, error)
+	// Search books by title, author, genre and isbn
+	SearchBooks(ctx context.Context, in *SearchBooksRequest, opts ...grpc.CallOption) (*SearchBooksResponse, error)
 }
 
 type libraryServiceClient struct {
@@ -82,6 +109,16 @@ func (c *libraryServiceClient) UpdateBook(ctx context.Context, in *UpdateBookReq
 	return out, nil
 }
 
+func (c *libraryServiceClient) PatchBook(ctx context.Context, in *PatchBookRequest, opts ...grpc.CallOption) (*PatchBookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PatchBookResponse)
+	err := c.cc.Invoke(ctx, LibraryService_PatchBook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *libraryServiceClient) DeleteBook(ctx context.Context, in *DeleteBookRequest, opts ...grpc.CallOption) (*DeleteBookResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteBookResponse)
@@ -102,7 +139,108 @@ func (c *libraryServiceClient) ListBooks(ctx context.Context, in *ListBooksReque
 	return out, nil
 }
 
-// LibraryServiceServer is the libraryServer API for LibraryService service.
+func (c *libraryServiceClient) StreamBooks(ctx context.Context, in *StreamBooksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamBooksResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LibraryService_ServiceDesc.Streams[0], LibraryService_StreamBooks_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamBooksRequest, StreamBooksResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LibraryService_StreamBooksClient = grpc.ServerStreamingClient[StreamBooksResponse]
+
+func (c *libraryServiceClient) ImportBooks(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[Book, ImportBooksResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LibraryService_ServiceDesc.Streams[1], LibraryService_ImportBooks_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Book, ImportBooksResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LibraryService_ImportBooksClient = grpc.ClientStreamingClient[Book, ImportBooksResponse]
+
+func (c *libraryServiceClient) WatchBooks(ctx context.Context, in *WatchBooksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BookEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LibraryService_ServiceDesc.Streams[2], LibraryService_WatchBooks_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchBooksRequest, BookEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LibraryService_WatchBooksClient = grpc.ServerStreamingClient[BookEvent]
+
+func (c *libraryServiceClient) BatchCreateBooks(ctx context.Context, in *BatchCreateBooksRequest, opts ...grpc.CallOption) (*BatchCreateBooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateBooksResponse)
+	err := c.cc.Invoke(ctx, LibraryService_BatchCreateBooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryServiceClient) BatchUpdateBooks(ctx context.Context, in *BatchUpdateBooksRequest, opts ...grpc.CallOption) (*BatchUpdateBooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchUpdateBooksResponse)
+	err := c.cc.Invoke(ctx, LibraryService_BatchUpdateBooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryServiceClient) BatchDeleteBooks(ctx context.Context, in *BatchDeleteBooksRequest, opts ...grpc.CallOption) (*BatchDeleteBooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchDeleteBooksResponse)
+	err := c.cc.Invoke(ctx, LibraryService_BatchDeleteBooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryServiceClient) BatchGetBooks(ctx context.Context, in *BatchGetBooksRequest, opts ...grpc.CallOption) (*BatchGetBooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetBooksResponse)
+	err := c.cc.Invoke(ctx, LibraryService_BatchGetBooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryServiceClient) SearchBooks(ctx context.Context, in *SearchBooksRequest, opts ...grpc.CallOption) (*SearchBooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchBooksResponse)
+	err := c.cc.Invoke(ctx, LibraryService_SearchBooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LibraryServiceServer is the server API for LibraryService service.
 // All implementations must embed UnimplementedLibraryServiceServer
 // for forward compatibility.
 //
@@ -114,10 +252,28 @@ type LibraryServiceServer interface {
 	GetBook(context.Context, *GetBookRequest) (*GetBookResponse, error)
 	// Update an existing book
 	UpdateBook(context.Context, *UpdateBookRequest) (*UpdateBookResponse, error)
+	// Update only the fields of a book listed in a field mask
+	PatchBook(context.Context, *PatchBookRequest) (*PatchBookResponse, error)
 	// Delete a book by ID
 	DeleteBook(context.Context, *DeleteBookRequest) (*DeleteBookResponse, error)
-	// List all books
+	// List books with pagination, filtering and ordering
 	ListBooks(context.Context, *ListBooksRequest) (*ListBooksResponse, error)
+	// Stream all matching books in chunks, for catalogs too large for one message
+	StreamBooks(*StreamBooksRequest, grpc.ServerStreamingServer[StreamBooksResponse]) error
+	// Import a stream of books, creating or updating them by ISBN
+	ImportBooks(grpc.ClientStreamingServer[Book, ImportBooksResponse]) error
+	// Follow changes to the catalog as they happen
+	WatchBooks(*WatchBooksRequest, grpc.ServerStreamingServer[BookEvent]) error
+	// Create several books in one transaction
+	BatchCreateBooks(context.Context, *BatchCreateBooksRequest) (*BatchCreateBooksResponse, error)
+	// Update several books in one transaction
+	BatchUpdateBooks(context.Context, *BatchUpdateBooksRequest) (*BatchUpdateBooksResponse, error)
+	// Delete several books in one transaction
+	BatchDeleteBooks(context.Context, *BatchDeleteBooksRequest) (*BatchDeleteBooksResponse, error)
+	// Get several books from one consistent view of the catalog
+	BatchGetBooks(context.Context, *BatchGetBooksRequest) (*BatchGetBooksResponse, error)
+	// Search books by title, author, genre and isbn
+	SearchBooks(context.Context, *SearchBooksRequest) (*SearchBooksResponse, error)
 	mustEmbedUnimplementedLibraryServiceServer()
 }
 
@@ -137,12 +293,39 @@ func (UnimplementedLibraryServiceServer) GetBook(context.Context, *GetBookReques
 func (UnimplementedLibraryServiceServer) UpdateBook(context.Context, *UpdateBookRequest) (*UpdateBookResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateBook not implemented")
 }
+func (UnimplementedLibraryServiceServer) PatchBook(context.Context, *PatchBookRequest) (*PatchBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchBook not implemented")
+}
 func (UnimplementedLibraryServiceServer) DeleteBook(context.Context, *DeleteBookRequest) (*DeleteBookResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteBook not implemented")
 }
 func (UnimplementedLibraryServiceServer) ListBooks(context.Context, *ListBooksRequest) (*ListBooksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBooks not implemented")
 }
+func (UnimplementedLibraryServiceServer) StreamBooks(*StreamBooksRequest, grpc.ServerStreamingServer[StreamBooksResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBooks not implemented")
+}
+func (UnimplementedLibraryServiceServer) ImportBooks(grpc.ClientStreamingServer[Book, ImportBooksResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportBooks not implemented")
+}
+func (UnimplementedLibraryServiceServer) WatchBooks(*WatchBooksRequest, grpc.ServerStreamingServer[BookEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchBooks not implemented")
+}
+func (UnimplementedLibraryServiceServer) BatchCreateBooks(context.Context, *BatchCreateBooksRequest) (*BatchCreateBooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateBooks not implemented")
+}
+func (UnimplementedLibraryServiceServer) BatchUpdateBooks(context.Context, *BatchUpdateBooksRequest) (*BatchUpdateBooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateBooks not implemented")
+}
+func (UnimplementedLibraryServiceServer) BatchDeleteBooks(context.Context, *BatchDeleteBooksRequest) (*BatchDeleteBooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteBooks not implemented")
+}
+func (UnimplementedLibraryServiceServer) BatchGetBooks(context.Context, *BatchGetBooksRequest) (*BatchGetBooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetBooks not implemented")
+}
+func (UnimplementedLibraryServiceServer) SearchBooks(context.Context, *SearchBooksRequest) (*SearchBooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchBooks not implemented")
+}
 func (UnimplementedLibraryServiceServer) mustEmbedUnimplementedLibraryServiceServer() {}
 func (UnimplementedLibraryServiceServer) testEmbeddedByValue()                        {}
 
@@ -218,6 +401,24 @@ func _LibraryService_UpdateBook_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LibraryService_PatchBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).PatchBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_PatchBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).PatchBook(ctx, req.(*PatchBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LibraryService_DeleteBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteBookRequest)
 	if err := dec(in); err != nil {
@@ -254,6 +455,125 @@ func _LibraryService_ListBooks_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LibraryService_StreamBooks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamBooksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LibraryServiceServer).StreamBooks(m, &grpc.GenericServerStream[StreamBooksRequest, StreamBooksResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LibraryService_StreamBooksServer = grpc.ServerStreamingServer[StreamBooksResponse]
+
+func _LibraryService_ImportBooks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LibraryServiceServer).ImportBooks(&grpc.GenericServerStream[Book, ImportBooksResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LibraryService_ImportBooksServer = grpc.ClientStreamingServer[Book, ImportBooksResponse]
+
+func _LibraryService_WatchBooks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchBooksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LibraryServiceServer).WatchBooks(m, &grpc.GenericServerStream[WatchBooksRequest, BookEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LibraryService_WatchBooksServer = grpc.ServerStreamingServer[BookEvent]
+
+func _LibraryService_BatchCreateBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateBooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).BatchCreateBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_BatchCreateBooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).BatchCreateBooks(ctx, req.(*BatchCreateBooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LibraryService_BatchUpdateBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateBooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).BatchUpdateBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_BatchUpdateBooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).BatchUpdateBooks(ctx, req.(*BatchUpdateBooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LibraryService_BatchDeleteBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteBooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).BatchDeleteBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_BatchDeleteBooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).BatchDeleteBooks(ctx, req.(*BatchDeleteBooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LibraryService_BatchGetBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetBooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).BatchGetBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_BatchGetBooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).BatchGetBooks(ctx, req.(*BatchGetBooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LibraryService_SearchBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchBooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).SearchBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_SearchBooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).SearchBooks(ctx, req.(*SearchBooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LibraryService_ServiceDesc is the grpc.ServiceDesc for LibraryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -273,6 +593,10 @@ var LibraryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateBook",
 			Handler:    _LibraryService_UpdateBook_Handler,
 		},
+		{
+			MethodName: "PatchBook",
+			Handler:    _LibraryService_PatchBook_Handler,
+		},
 		{
 			MethodName: "DeleteBook",
 			Handler:    _LibraryService_DeleteBook_Handler,
@@ -281,7 +605,43 @@ var LibraryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListBooks",
 			Handler:    _LibraryService_ListBooks_Handler,
 		},
+		{
+			MethodName: "BatchCreateBooks",
+			Handler:    _LibraryService_BatchCreateBooks_Handler,
+		},
+		{
+			MethodName: "BatchUpdateBooks",
+			Handler:    _LibraryService_BatchUpdateBooks_Handler,
+		},
+		{
+			MethodName: "BatchDeleteBooks",
+			Handler:    _LibraryService_BatchDeleteBooks_Handler,
+		},
+		{
+			MethodName: "BatchGetBooks",
+			Handler:    _LibraryService_BatchGetBooks_Handler,
+		},
+		{
+			MethodName: "SearchBooks",
+			Handler:    _LibraryService_SearchBooks_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBooks",
+			Handler:       _LibraryService_StreamBooks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportBooks",
+			Handler:       _LibraryService_ImportBooks_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchBooks",
+			Handler:       _LibraryService_WatchBooks_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/library.proto",
 }
@@ -2,21 +2,109 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"flag"
+	"fmt"
 	"log"
 	"math/rand"
+	"os"
 	"sync"
 	"time"
 
 	pb "github.com/Horizon-School-of-Digital-Technologies/library/api" // Replace with the actual path where the generated proto files are
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
 )
 
+// Command-line flags
+var (
+	addr       = flag.String("addr", "localhost:50051", "address of the server")
+	useTLS     = flag.Bool("tls", false, "connect with TLS, implied by the other TLS flags")
+	caFile     = flag.String("ca-file", "", "PEM certificates of the CAs trusted to sign the server certificate, the system roots if unset")
+	certFile   = flag.String("cert-file", "", "PEM client certificate presented for mutual TLS")
+	keyFile    = flag.String("key-file", "", "PEM private key of the client certificate")
+	serverName = flag.String("server-name", "", "name expected in the server certificate, the host of -addr if unset")
+	apiKey     = flag.String("api-key", "", "API key sent in the x-api-key header")
+	token      = flag.String("token", "", "JWT sent as a bearer token")
+)
+
+// callCredentials attaches the API key or bearer token to every call
+type callCredentials struct {
+	md     map[string]string
+	secure bool // Whether the connection uses TLS
+}
+
+// GetRequestMetadata implements credentials.PerRPCCredentials
+func (c callCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return c.md, nil
+}
+
+// RequireTransportSecurity implements credentials.PerRPCCredentials.
+// Credentials are only sent in plaintext to servers without TLS.
+func (c callCredentials) RequireTransportSecurity() bool {
+	return c.secure
+}
+
+// transportCredentials returns the TLS credentials selected by the flags,
+// or nil for a plaintext connection
+func transportCredentials() (credentials.TransportCredentials, error) {
+	if !*useTLS && *caFile == "" && *certFile == "" && *keyFile == "" && *serverName == "" {
+		return nil, nil
+	}
+	config := &tls.Config{MinVersion: tls.VersionTLS12, ServerName: *serverName}
+	if *caFile != "" {
+		data, err := os.ReadFile(*caFile)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("%s: no PEM certificates found", *caFile)
+		}
+	}
+	if *certFile != "" || *keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(*certFile, *keyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return credentials.NewTLS(config), nil
+}
+
+// createdIDs holds the IDs assigned by the server to the books created so far
+var (
+	createdIDs []int32
+	idsMu      sync.Mutex
+)
+
+// rememberID records an ID returned by CreateBook
+func rememberID(id int32) {
+	idsMu.Lock()
+	defer idsMu.Unlock()
+	createdIDs = append(createdIDs, id)
+}
+
+// randomID picks one of the created IDs, or 0 if none were created yet
+func randomID() int32 {
+	idsMu.Lock()
+	defer idsMu.Unlock()
+	if len(createdIDs) == 0 {
+		return 0
+	}
+	return createdIDs[rand.Intn(len(createdIDs))]
+}
+
+// RandomBook returns a book with random data and no ID, so that the server
+// assigns one on creation
 func RandomBook() *pb.Book {
 	return &pb.Book{
-		Id:              int32(rand.Intn(10)),
 		Title:           randString(20),
 		Author:          randString(15),
-		Isbn:            randString(15),
+		Isbn:            randISBN(),
 		PublicationYear: int32(rand.Intn(2023-1900) + 1900), // Random year between 1900 and 2023
 		Genre:           randString(10),
 	}
@@ -32,6 +120,46 @@ func randString(length int) string {
 	return string(b)
 }
 
+// randISBN generates a random ISBN-13 with a valid check digit
+func randISBN() string {
+	digits := []byte("978")
+	for len(digits) < 12 {
+		digits = append(digits, byte('0'+rand.Intn(10)))
+	}
+	sum := 0
+	for i, c := range digits {
+		w := 1
+		if i%2 == 1 {
+			w = 3
+		}
+		sum += w * int(c-'0')
+	}
+	return string(append(digits, byte('0'+(10-sum%10)%10)))
+}
+
+// updateBook replaces a book using compare-and-swap on its revision: the
+// current revision is read first and the update retried if another client
+// changed the book in between, instead of silently overwriting that change
+func updateBook(client pb.LibraryServiceClient, book *pb.Book) (*pb.Book, error) {
+	for attempt := 0; ; attempt++ {
+		current, err := client.GetBook(context.Background(), &pb.GetBookRequest{Id: book.Id})
+		if err != nil {
+			return nil, err
+		}
+		book.Revision = current.Book.Revision
+
+		resp, err := client.UpdateBook(context.Background(), &pb.UpdateBookRequest{Book: book})
+		if status.Code(err) == codes.Aborted && attempt < 3 {
+			log.Printf("Book %d changed concurrently, retrying", book.Id)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return resp.Book, nil
+	}
+}
+
 // loadTest performs random queries on the library service
 func loadTest(client pb.LibraryServiceClient, wg *sync.WaitGroup) {
 	defer wg.Done()
@@ -39,14 +167,18 @@ func loadTest(client pb.LibraryServiceClient, wg *sync.WaitGroup) {
 	for i := 0; i < 10; i++ { // Number of operations per goroutine
 		operation := rand.Intn(4) // Randomly choose operation
 		book := RandomBook()
+		if operation != 0 {
+			book.Id = randomID()
+		}
 
 		switch operation {
 		case 0: // CreateBook
-			_, err := client.CreateBook(context.Background(), &pb.CreateBookRequest{Book: book})
+			resp, err := client.CreateBook(context.Background(), &pb.CreateBookRequest{Book: book})
 			if err != nil {
 				log.Printf("Failed to create book: %v", err)
 			} else {
-				log.Printf("Created book: %v", book)
+				rememberID(resp.Book.Id)
+				log.Printf("Created book: %v", resp.Book)
 			}
 
 		case 1: // GetBook
@@ -58,11 +190,11 @@ func loadTest(client pb.LibraryServiceClient, wg *sync.WaitGroup) {
 			}
 
 		case 2: // UpdateBook
-			_, err := client.UpdateBook(context.Background(), &pb.UpdateBookRequest{Book: book})
+			updated, err := updateBook(client, book)
 			if err != nil {
 				log.Printf("Failed to update book: %v", err)
 			} else {
-				log.Printf("Updated book: %v", book)
+				log.Printf("Updated book: %v", updated)
 			}
 
 		case 3: // DeleteBook
@@ -83,8 +215,28 @@ func main() {
 	// Seed the random number generator
 	rand.Seed(time.Now().UnixNano())
 
+	flag.Parse()
+
 	// Establish connection to the gRPC server
-	conn, err := grpc.Dial("localhost:50051", grpc.WithInsecure())
+	creds, err := transportCredentials()
+	if err != nil {
+		log.Fatalf("Failed to load TLS certificates: %v", err)
+	}
+	opts := []grpc.DialOption{grpc.WithInsecure()}
+	if creds != nil {
+		opts = []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	}
+	switch {
+	case *apiKey != "":
+		opts = append(opts, grpc.WithPerRPCCredentials(callCredentials{
+			md: map[string]string{"x-api-key": *apiKey}, secure: creds != nil,
+		}))
+	case *token != "":
+		opts = append(opts, grpc.WithPerRPCCredentials(callCredentials{
+			md: map[string]string{"authorization": "Bearer " + *token}, secure: creds != nil,
+		}))
+	}
+	conn, err := grpc.Dial(*addr, opts...)
 	if err != nil {
 		log.Fatalf("Failed to connect to server: %v", err)
 	}
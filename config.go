@@ -0,0 +1,388 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	sv "github.com/Horizon-School-of-Digital-Technologies/library/server"
+	"gopkg.in/yaml.v3"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"time"
+)
+
+// envPrefix starts the environment variable of every setting: the flag
+// name in upper case with hyphens turned into underscores, e.g.
+// LIBRARY_DATA_DIR for -data-dir
+const envPrefix = "LIBRARY_"
+
+// configEnv names the configuration file when -config is not given
+const configEnv = envPrefix + "CONFIG"
+
+// Config is the configuration of the server. Every setting is read, from
+// lowest to highest precedence, from the built-in defaults, the YAML file
+// named by -config or LIBRARY_CONFIG, the LIBRARY_* environment variables
+// and the command-line flags.
+//
+// Some limits are deliberately fixed rather than configurable: the default
+// and maximum page sizes of ListBooks, SearchBooks and StreamBooks and the
+// batch size limit are documented in the API, so clients may rely on them,
+// while the import transaction size, the import failure limit and the
+// snapshot chunk size only tune how the server does its work.
+type Config struct {
+	GRPC    grpcConfig    `yaml:"grpc"`
+	Metrics metricsConfig `yaml:"metrics"`
+	Store   storeConfig   `yaml:"store"`
+	Fees    feesConfig    `yaml:"fees"`
+	Lending lendingConfig `yaml:"lending"`
+	Watch   watchConfig   `yaml:"watch"`
+	Auth    authConfig    `yaml:"auth"`
+	TLS     tlsConfig     `yaml:"tls"`
+}
+
+// grpcConfig configures the gRPC listener
+type grpcConfig struct {
+	Address             string `yaml:"address"`
+	MaxRecvMessageBytes int    `yaml:"max_recv_message_bytes"`
+}
+
+// metricsConfig configures the Prometheus endpoint
+type metricsConfig struct {
+	Address string `yaml:"address"`
+}
+
+// storeConfig configures the storage backend
+type storeConfig struct {
+	Kind            string        `yaml:"kind"`
+	DataDir         string        `yaml:"data_dir"`
+	CompactInterval time.Duration `yaml:"compact_interval"`
+}
+
+// feesConfig configures the fee amounts, in minor currency units
+type feesConfig struct {
+	OverduePerDay  int64 `yaml:"overdue_per_day"`
+	OverdueCap     int64 `yaml:"overdue_cap"`
+	LostItemCap    int64 `yaml:"lost_item_cap"`
+	ManualFeeCap   int64 `yaml:"manual_fee_cap"`
+	BlockThreshold int64 `yaml:"block_threshold"`
+}
+
+// lendingConfig configures the lending rules of each membership tier and
+// holds
+type lendingConfig struct {
+	Standard     tierConfig    `yaml:"standard"`
+	Student      tierConfig    `yaml:"student"`
+	Premium      tierConfig    `yaml:"premium"`
+	Staff        tierConfig    `yaml:"staff"`
+	PickupWindow time.Duration `yaml:"hold_pickup_window"`
+}
+
+// tierConfig configures the lending rules of one membership tier
+type tierConfig struct {
+	MaxLoans    int           `yaml:"max_loans"`
+	LoanPeriod  time.Duration `yaml:"loan_period"`
+	MaxRenewals int           `yaml:"max_renewals"`
+}
+
+// tierSetting is the configuration of one tier
+type tierSetting struct {
+	tier pb.Member_Tier
+	cfg  *tierConfig
+}
+
+// name returns the tier as named in flags and error messages
+func (t tierSetting) name() string {
+	return strings.ToLower(t.tier.String())
+}
+
+// tiers returns the settings of every tier, in tier order
+func (c *lendingConfig) tiers() []tierSetting {
+	return []tierSetting{
+		{pb.Member_STANDARD, &c.Standard},
+		{pb.Member_STUDENT, &c.Student},
+		{pb.Member_PREMIUM, &c.Premium},
+		{pb.Member_STAFF, &c.Staff},
+	}
+}
+
+// watchConfig configures WatchBooks
+type watchConfig struct {
+	HistorySize int `yaml:"history_size"`
+}
+
+// maxWatchHistory bounds watch.history_size, as the history is held in
+// memory
+const maxWatchHistory = 1000000
+
+// authConfig configures authentication and authorization
+type authConfig struct {
+	JWKSFile             string `yaml:"jwks_file"`
+	JWTIssuer            string `yaml:"jwt_issuer"`
+	JWTAudience          string `yaml:"jwt_audience"`
+	APIKeysFile          string `yaml:"api_keys_file"`
+	ClientIdentitiesFile string `yaml:"client_identities_file"`
+	PolicyFile           string `yaml:"policy_file"`
+	InsecureNoAuth       bool   `yaml:"insecure_no_auth"`
+}
+
+// tlsConfig configures TLS on the gRPC listener
+type tlsConfig struct {
+	CertFile           string `yaml:"cert_file"`
+	KeyFile            string `yaml:"key_file"`
+	ClientCAFile       string `yaml:"client_ca_file"`
+	ClientCertOptional bool   `yaml:"client_cert_optional"`
+}
+
+// defaultConfig returns the configuration used when nothing is set
+func defaultConfig() *Config {
+	cfg := &Config{}
+	cfg.GRPC.Address = ":50051"
+	cfg.GRPC.MaxRecvMessageBytes = 4 << 20
+	cfg.Metrics.Address = ":2112"
+	cfg.Store.Kind = "memory"
+	cfg.Store.DataDir = "data"
+	cfg.Store.CompactInterval = 10 * time.Minute
+	fees := sv.DefaultFeePolicy
+	cfg.Fees.OverduePerDay = fees.OverduePerDay
+	cfg.Fees.OverdueCap = fees.OverdueCap
+	cfg.Fees.LostItemCap = fees.LostItemCap
+	cfg.Fees.ManualFeeCap = fees.ManualFeeCap
+	cfg.Fees.BlockThreshold = fees.BlockThreshold
+	lending := sv.DefaultLendingPolicy
+	for _, ts := range cfg.Lending.tiers() {
+		t := lending.Tiers[ts.tier]
+		*ts.cfg = tierConfig{MaxLoans: t.MaxLoans, LoanPeriod: t.LoanPeriod, MaxRenewals: int(t.MaxRenewals)}
+	}
+	cfg.Lending.PickupWindow = lending.PickupWindow
+	cfg.Watch.HistorySize = sv.DefaultWatchHistory
+	return cfg
+}
+
+// FeePolicy returns the fee settings as a server FeePolicy
+func (cfg *Config) FeePolicy() sv.FeePolicy {
+	return sv.FeePolicy{
+		OverduePerDay:  cfg.Fees.OverduePerDay,
+		OverdueCap:     cfg.Fees.OverdueCap,
+		LostItemCap:    cfg.Fees.LostItemCap,
+		ManualFeeCap:   cfg.Fees.ManualFeeCap,
+		BlockThreshold: cfg.Fees.BlockThreshold,
+	}
+}
+
+// LendingPolicy returns the lending settings as a server LendingPolicy
+func (cfg *Config) LendingPolicy() sv.LendingPolicy {
+	p := sv.LendingPolicy{
+		Tiers:        make(map[pb.Member_Tier]sv.TierPolicy),
+		PickupWindow: cfg.Lending.PickupWindow,
+	}
+	for _, ts := range cfg.Lending.tiers() {
+		c := ts.cfg
+		p.Tiers[ts.tier] = sv.TierPolicy{MaxLoans: c.MaxLoans, LoanPeriod: c.LoanPeriod, MaxRenewals: int32(c.MaxRenewals)}
+	}
+	return p
+}
+
+// cliOptions are the flags that select what the command does rather than
+// configure the server
+type cliOptions struct {
+	configFile  string
+	printConfig bool
+}
+
+// newFlagSet returns the command-line flags, bound to the fields of cfg and
+// opts with their current values as defaults
+func newFlagSet(cfg *Config, opts *cliOptions) *flag.FlagSet {
+	fs := flag.NewFlagSet(os.Args[0], flag.ContinueOnError)
+	fs.StringVar(&opts.configFile, "config", opts.configFile, "YAML configuration file, also read from "+configEnv)
+	fs.BoolVar(&opts.printConfig, "print-config", false, "print the effective configuration as YAML and exit")
+
+	fs.StringVar(&cfg.GRPC.Address, "grpc-address", cfg.GRPC.Address, "address the gRPC server listens on")
+	fs.IntVar(&cfg.GRPC.MaxRecvMessageBytes, "max-recv-message-bytes", cfg.GRPC.MaxRecvMessageBytes, "largest request message accepted, in bytes")
+	fs.StringVar(&cfg.Metrics.Address, "metrics-address", cfg.Metrics.Address, "address Prometheus metrics are served on at /metrics")
+
+	fs.StringVar(&cfg.Store.Kind, "store", cfg.Store.Kind, "storage backend: memory, file or sqlite")
+	fs.StringVar(&cfg.Store.DataDir, "data-dir", cfg.Store.DataDir, "directory used by the file and sqlite stores")
+	fs.DurationVar(&cfg.Store.CompactInterval, "compact-interval", cfg.Store.CompactInterval, "how often the write-ahead log is compacted into a snapshot, 0 to never")
+
+	fs.Int64Var(&cfg.Fees.OverduePerDay, "overdue-fine-per-day", cfg.Fees.OverduePerDay, "overdue fine per day late, in minor currency units")
+	fs.Int64Var(&cfg.Fees.OverdueCap, "overdue-fine-cap", cfg.Fees.OverdueCap, "most a single loan can be fined for being late, in minor currency units")
+	fs.Int64Var(&cfg.Fees.LostItemCap, "lost-item-fee-cap", cfg.Fees.LostItemCap, "most a lost copy can be charged, in minor currency units")
+	fs.Int64Var(&cfg.Fees.ManualFeeCap, "manual-fee-cap", cfg.Fees.ManualFeeCap, "most a single manual fee can be, in minor currency units")
+	fs.Int64Var(&cfg.Fees.BlockThreshold, "fee-block-threshold", cfg.Fees.BlockThreshold, "balance in minor currency units from which borrowing is blocked, 0 to never block")
+
+	for _, ts := range cfg.Lending.tiers() {
+		c, name := ts.cfg, ts.name()
+		fs.IntVar(&c.MaxLoans, name+"-max-loans", c.MaxLoans, "most books a "+name+" member can have on loan at once")
+		fs.DurationVar(&c.LoanPeriod, name+"-loan-period", c.LoanPeriod, "how long a "+name+" member can keep a book, also the length of a renewal")
+		fs.IntVar(&c.MaxRenewals, name+"-max-renewals", c.MaxRenewals, "how often a "+name+" member can renew a loan")
+	}
+	fs.DurationVar(&cfg.Lending.PickupWindow, "hold-pickup-window", cfg.Lending.PickupWindow, "how long a copy is kept for a member once their hold is ready")
+	fs.IntVar(&cfg.Watch.HistorySize, "watch-history", cfg.Watch.HistorySize, "number of past book events kept for resuming WatchBooks")
+
+	fs.StringVar(&cfg.Auth.JWKSFile, "jwks-file", cfg.Auth.JWKSFile, "JSON Web Key Set with the public keys bearer tokens are signed with")
+	fs.StringVar(&cfg.Auth.JWTIssuer, "jwt-issuer", cfg.Auth.JWTIssuer, "issuer bearer tokens must have, if set")
+	fs.StringVar(&cfg.Auth.JWTAudience, "jwt-audience", cfg.Auth.JWTAudience, "audience bearer tokens must include, if set")
+	fs.StringVar(&cfg.Auth.APIKeysFile, "api-keys-file", cfg.Auth.APIKeysFile, `file of static API keys, one "name key [role ...]" per line`)
+	fs.StringVar(&cfg.Auth.ClientIdentitiesFile, "client-identities-file", cfg.Auth.ClientIdentitiesFile, "JSON file mapping client certificate subjects to identities and roles")
+	fs.StringVar(&cfg.Auth.PolicyFile, "policy-file", cfg.Auth.PolicyFile, "JSON file granting permissions to roles, reloaded when it changes; a built-in patron, librarian and admin policy if unset")
+	fs.BoolVar(&cfg.Auth.InsecureNoAuth, "insecure-no-auth", cfg.Auth.InsecureNoAuth, "serve without authentication, for local development only")
+
+	fs.StringVar(&cfg.TLS.CertFile, "tls-cert", cfg.TLS.CertFile, "PEM certificate chain of the server, reloaded when it changes; plaintext if unset")
+	fs.StringVar(&cfg.TLS.KeyFile, "tls-key", cfg.TLS.KeyFile, "PEM private key of the server, reloaded when it changes")
+	fs.StringVar(&cfg.TLS.ClientCAFile, "tls-client-ca", cfg.TLS.ClientCAFile, "PEM certificates of the CAs signing client certificates, which clients must then present")
+	fs.BoolVar(&cfg.TLS.ClientCertOptional, "tls-client-cert-optional", cfg.TLS.ClientCertOptional, "verify client certificates when presented instead of requiring them")
+
+	fs.Usage = func() {
+		out := fs.Output()
+		fmt.Fprintf(out, "Usage of %s:\n\n", fs.Name())
+		fmt.Fprintln(out, "Settings are read from the built-in defaults, then the YAML file named by")
+		fmt.Fprintf(out, "-config or %s, then %s* environment variables named after the\n", configEnv, envPrefix)
+		fmt.Fprintln(out, "flags (LIBRARY_DATA_DIR for -data-dir), then the flags; later sources win.")
+		fmt.Fprintln(out)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// envName returns the environment variable of a flag
+func envName(flagName string) string {
+	return envPrefix + strings.ToUpper(strings.ReplaceAll(flagName, "-", "_"))
+}
+
+// loadConfigFile decodes a YAML configuration file over cfg. Unknown keys
+// are rejected so that a misspelled setting does not go unnoticed.
+func loadConfigFile(path string, cfg *Config) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	dec := yaml.NewDecoder(f)
+	dec.KnownFields(true)
+	if err := dec.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// loadConfig builds the configuration from the defaults, the configuration
+// file, the environment and args, in increasing order of precedence
+func loadConfig(args []string) (*Config, *cliOptions, error) {
+	// A first pass over the flags only finds the configuration file, so that
+	// the flags can then be applied on top of it
+	opts := &cliOptions{configFile: os.Getenv(configEnv)}
+	if err := newFlagSet(defaultConfig(), opts).Parse(args); err != nil {
+		return nil, nil, err
+	}
+
+	cfg := defaultConfig()
+	if opts.configFile != "" {
+		if err := loadConfigFile(opts.configFile, cfg); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	fs := newFlagSet(cfg, opts)
+	fs.SetOutput(io.Discard)
+	var errs []error
+	fs.VisitAll(func(f *flag.Flag) {
+		// The configuration file was taken from the environment above, and
+		// -print-config is a command rather than a setting
+		if f.Name == "config" || f.Name == "print-config" {
+			return
+		}
+		name := envName(f.Name)
+		if value, ok := os.LookupEnv(name); ok {
+			if err := fs.Set(f.Name, value); err != nil {
+				errs = append(errs, fmt.Errorf("%s=%q: %w", name, value, err))
+			}
+		}
+	})
+	if err := errors.Join(errs...); err != nil {
+		return nil, nil, err
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, nil, err
+	}
+	return cfg, opts, nil
+}
+
+// checkAddress validates a listen address of the form "host:port"
+func checkAddress(setting, addr string) error {
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		return fmt.Errorf("%s: %w", setting, err)
+	}
+	return nil
+}
+
+// Validate reports every invalid or inconsistent setting
+func (cfg *Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	for _, err := range []error{
+		checkAddress("grpc.address", cfg.GRPC.Address),
+		checkAddress("metrics.address", cfg.Metrics.Address),
+	} {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	check(cfg.GRPC.MaxRecvMessageBytes > 0, "grpc.max_recv_message_bytes must be positive")
+
+	switch cfg.Store.Kind {
+	case "memory", "file", "sqlite":
+	default:
+		check(false, "store.kind must be memory, file or sqlite, not %q", cfg.Store.Kind)
+	}
+	check(cfg.Store.Kind == "memory" || cfg.Store.DataDir != "", "store.data_dir is required by the %s store", cfg.Store.Kind)
+	check(cfg.Store.CompactInterval >= 0, "store.compact_interval must not be negative")
+
+	check(cfg.Fees.OverduePerDay >= 0, "fees.overdue_per_day must not be negative")
+	check(cfg.Fees.OverdueCap >= 0, "fees.overdue_cap must not be negative")
+	check(cfg.Fees.LostItemCap >= 0, "fees.lost_item_cap must not be negative")
+	check(cfg.Fees.ManualFeeCap >= 0, "fees.manual_fee_cap must not be negative")
+	check(cfg.Fees.BlockThreshold >= 0, "fees.block_threshold must not be negative")
+
+	for _, ts := range cfg.Lending.tiers() {
+		c, name := ts.cfg, ts.name()
+		check(c.MaxLoans > 0, "lending.%s.max_loans must be positive", name)
+		check(c.LoanPeriod > 0, "lending.%s.loan_period must be positive", name)
+		check(c.MaxRenewals >= 0, "lending.%s.max_renewals must not be negative", name)
+	}
+	check(cfg.Lending.PickupWindow > 0, "lending.hold_pickup_window must be positive")
+	check(cfg.Watch.HistorySize > 0 && cfg.Watch.HistorySize <= maxWatchHistory,
+		"watch.history_size must be between 1 and %d", maxWatchHistory)
+
+	auth := cfg.Auth
+	check(auth.InsecureNoAuth || auth.JWKSFile != "" || auth.APIKeysFile != "" || auth.ClientIdentitiesFile != "",
+		"one of auth.jwks_file, auth.api_keys_file and auth.client_identities_file is required unless auth.insecure_no_auth is set")
+	check(auth.JWKSFile != "" || (auth.JWTIssuer == "" && auth.JWTAudience == ""),
+		"auth.jwt_issuer and auth.jwt_audience require auth.jwks_file")
+	check(auth.ClientIdentitiesFile == "" || cfg.TLS.ClientCAFile != "",
+		"auth.client_identities_file requires tls.client_ca_file")
+
+	tls := cfg.TLS
+	check((tls.CertFile == "") == (tls.KeyFile == ""), "tls.cert_file and tls.key_file must be set together")
+	check(tls.ClientCAFile == "" || tls.CertFile != "", "tls.client_ca_file requires tls.cert_file and tls.key_file")
+	check(!tls.ClientCertOptional || tls.ClientCAFile != "", "tls.client_cert_optional requires tls.client_ca_file")
+
+	return errors.Join(errs...)
+}
+
+// Print writes the configuration as YAML
+func (cfg *Config) Print(w io.Writer) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(cfg); err != nil {
+		return err
+	}
+	return enc.Close()
+}
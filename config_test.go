@@ -0,0 +1,190 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeConfigFile writes a YAML configuration file and returns its path
+func writeConfigFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "library.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadConfigPrecedence(t *testing.T) {
+	path := writeConfigFile(t, `
+grpc:
+  address: ":6000"
+store:
+  kind: file
+  data_dir: /var/lib/library
+watch:
+  history_size: 50
+`)
+	t.Setenv(configEnv, path)
+	t.Setenv("LIBRARY_DATA_DIR", "/srv/library")
+	t.Setenv("LIBRARY_WATCH_HISTORY", "75")
+
+	cfg, _, err := loadConfig([]string{"-watch-history", "100"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		setting   string
+		got, want any
+	}{
+		{"metrics.address from the defaults", cfg.Metrics.Address, ":2112"},
+		{"grpc.address from the file", cfg.GRPC.Address, ":6000"},
+		{"store.kind from the file", cfg.Store.Kind, "file"},
+		{"store.data_dir from the environment", cfg.Store.DataDir, "/srv/library"},
+		{"watch.history_size from the flags", cfg.Watch.HistorySize, 100},
+	} {
+		if tc.got != tc.want {
+			t.Errorf("%s: got %v, want %v", tc.setting, tc.got, tc.want)
+		}
+	}
+
+	// -config wins over LIBRARY_CONFIG
+	other := writeConfigFile(t, "grpc:\n  address: \":7000\"\n")
+	cfg, _, err = loadConfig([]string{"-config", other})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.GRPC.Address != ":7000" || cfg.Store.Kind != "memory" {
+		t.Errorf("got grpc.address %q and store.kind %q, want the settings of %s", cfg.GRPC.Address, cfg.Store.Kind, other)
+	}
+}
+
+func TestLoadConfigEnvironment(t *testing.T) {
+	t.Setenv("LIBRARY_COMPACT_INTERVAL", "90s")
+	t.Setenv("LIBRARY_STUDENT_LOAN_PERIOD", "336h")
+	t.Setenv("LIBRARY_INSECURE_NO_AUTH", "true")
+	t.Setenv("LIBRARY_TLS_CLIENT_CERT_OPTIONAL", "1")
+	cfg, _, err := loadConfig(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Store.CompactInterval != 90*time.Second {
+		t.Errorf("store.compact_interval: got %v, want 1m30s", cfg.Store.CompactInterval)
+	}
+	if cfg.Lending.Student.LoanPeriod != 14*24*time.Hour {
+		t.Errorf("lending.student.loan_period: got %v, want 336h", cfg.Lending.Student.LoanPeriod)
+	}
+	if !cfg.Auth.InsecureNoAuth || !cfg.TLS.ClientCertOptional {
+		t.Errorf("got auth.insecure_no_auth %v and tls.client_cert_optional %v, want both set",
+			cfg.Auth.InsecureNoAuth, cfg.TLS.ClientCertOptional)
+	}
+
+	// A flag given as false overrides the environment
+	cfg, _, err = loadConfig([]string{"-insecure-no-auth=false"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Auth.InsecureNoAuth {
+		t.Error("-insecure-no-auth=false should override LIBRARY_INSECURE_NO_AUTH")
+	}
+}
+
+func TestLoadConfigInvalidEnvironment(t *testing.T) {
+	t.Setenv("LIBRARY_COMPACT_INTERVAL", "10")
+	t.Setenv("LIBRARY_INSECURE_NO_AUTH", "maybe")
+	_, _, err := loadConfig(nil)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	for _, name := range []string{"LIBRARY_COMPACT_INTERVAL", "LIBRARY_INSECURE_NO_AUTH"} {
+		if !strings.Contains(err.Error(), name) {
+			t.Errorf("error %q does not name %s", err, name)
+		}
+	}
+}
+
+func TestLoadConfigPrintConfigIsFlagOnly(t *testing.T) {
+	t.Setenv("LIBRARY_PRINT_CONFIG", "true")
+	_, opts, err := loadConfig(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opts.printConfig {
+		t.Error("LIBRARY_PRINT_CONFIG should be ignored")
+	}
+	_, opts, err = loadConfig([]string{"-print-config"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !opts.printConfig {
+		t.Error("-print-config should be set")
+	}
+}
+
+func TestLoadConfigFileRejectsUnknownKeys(t *testing.T) {
+	for _, content := range []string{
+		"grpc:\n  adress: \":6000\"\n",
+		"stores:\n  kind: file\n",
+		"lending:\n  student:\n    max_loan: 3\n",
+	} {
+		cfg := defaultConfig()
+		if err := loadConfigFile(writeConfigFile(t, content), cfg); err == nil {
+			t.Errorf("%q: expected an error", content)
+		}
+	}
+
+	cfg := defaultConfig()
+	if err := loadConfigFile(writeConfigFile(t, ""), cfg); err != nil {
+		t.Errorf("empty file: %v", err)
+	}
+	if err := loadConfigFile(filepath.Join(t.TempDir(), "missing.yaml"), cfg); !os.IsNotExist(err) {
+		t.Errorf("missing file: got %v, want a not exist error", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		change func(cfg *Config)
+		want   string // Substring of the error, empty if valid
+	}{
+		{"defaults with API keys", func(cfg *Config) {}, ""},
+		{"insecure without credentials", func(cfg *Config) { cfg.Auth.APIKeysFile = ""; cfg.Auth.InsecureNoAuth = true }, ""},
+		{"no credentials", func(cfg *Config) { cfg.Auth.APIKeysFile = "" }, "auth.insecure_no_auth"},
+		{"address without port", func(cfg *Config) { cfg.GRPC.Address = "localhost" }, "grpc.address"},
+		{"unknown store", func(cfg *Config) { cfg.Store.Kind = "postgres" }, "store.kind"},
+		{"file store without data dir", func(cfg *Config) { cfg.Store.Kind = "file"; cfg.Store.DataDir = "" }, "store.data_dir"},
+		{"memory store without data dir", func(cfg *Config) { cfg.Store.DataDir = "" }, ""},
+		{"negative fee", func(cfg *Config) { cfg.Fees.OverdueCap = -1 }, "fees.overdue_cap"},
+		{"no loans", func(cfg *Config) { cfg.Lending.Staff.MaxLoans = 0 }, "lending.staff.max_loans"},
+		{"history too long", func(cfg *Config) { cfg.Watch.HistorySize = maxWatchHistory + 1 }, "watch.history_size"},
+		{"issuer without keys", func(cfg *Config) { cfg.Auth.JWTIssuer = "https://issuer" }, "auth.jwks_file"},
+		{"certificate without key", func(cfg *Config) { cfg.TLS.CertFile = "server.pem" }, "tls.key_file"},
+		{"client identities without CA", func(cfg *Config) { cfg.Auth.ClientIdentitiesFile = "clients.json" }, "tls.client_ca_file"},
+	} {
+		cfg := defaultConfig()
+		cfg.Auth.APIKeysFile = "keys.txt"
+		tc.change(cfg)
+		err := cfg.Validate()
+		switch {
+		case tc.want == "" && err != nil:
+			t.Errorf("%s: %v", tc.name, err)
+		case tc.want != "" && (err == nil || !strings.Contains(err.Error(), tc.want)):
+			t.Errorf("%s: got %v, want an error about %s", tc.name, err, tc.want)
+		}
+	}
+
+	// Every invalid setting is reported at once
+	cfg := defaultConfig()
+	cfg.GRPC.MaxRecvMessageBytes = 0
+	cfg.Lending.PickupWindow = 0
+	err := cfg.Validate()
+	for _, want := range []string{"grpc.max_recv_message_bytes", "lending.hold_pickup_window", "auth.jwks_file"} {
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("got %v, want an error about %s", err, want)
+		}
+	}
+}
@@ -2,14 +2,24 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
+	"fmt"
 	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
 	sv "github.com/Horizon-School-of-Digital-Technologies/library/server"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
 	"log"
+	_ "modernc.org/sqlite" // pure-Go SQLite driver registered as "sqlite"
 	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
 	"time"
 )
 
@@ -59,7 +69,7 @@ func prometheusUnaryInterceptor(
 	method := info.FullMethod
 
 	// Collect metrics
-	grpcRequests.WithLabelValues(method, grpc.Code(err).String()).Inc()
+	grpcRequests.WithLabelValues(method, status.Code(err).String()).Inc()
 	grpcRequestDuration.WithLabelValues(method).Observe(duration)
 
 	if err != nil {
@@ -69,47 +79,181 @@ func prometheusUnaryInterceptor(
 	return resp, err
 }
 
+// Stream interceptor to collect Prometheus metrics
+func prometheusStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	// Start time for measuring the lifetime of the stream
+	startTime := time.Now()
+
+	// Handle the stream
+	err := handler(srv, ss)
+
+	// Collect metrics
+	method := info.FullMethod
+	grpcRequests.WithLabelValues(method, status.Code(err).String()).Inc()
+	grpcRequestDuration.WithLabelValues(method).Observe(time.Since(startTime).Seconds())
+
+	if err != nil {
+		grpcRequestErrors.WithLabelValues(method).Inc()
+	}
+
+	return err
+}
+
 // Function to expose Prometheus metrics
-func exposePrometheusMetrics() {
+func exposePrometheusMetrics(addr string) {
 	http.Handle("/metrics", promhttp.Handler())
 	go func() {
-		log.Println("Prometheus metrics exposed on :2112/metrics")
-		if err := http.ListenAndServe(":2112", nil); err != nil {
+		log.Printf("Prometheus metrics exposed on %s/metrics", addr)
+		if err := http.ListenAndServe(addr, nil); err != nil {
 			log.Fatalf("Failed to expose Prometheus metrics: %v", err)
 		}
 	}()
 }
 
+// Function to open the configured storage backend
+func openStore(cfg *Config) (sv.Store, error) {
+	dataDir := cfg.Store.DataDir
+	switch cfg.Store.Kind {
+	case "memory":
+		log.Println("Using the in-memory store, books will not survive a restart")
+		return sv.NewBookStore(), nil
+	case "file":
+		return sv.NewFileStore(dataDir, cfg.Store.CompactInterval)
+	case "sqlite":
+		if err := os.MkdirAll(dataDir, 0o755); err != nil {
+			return nil, err
+		}
+		dsn := "file:" + filepath.Join(dataDir, "library.db") + "?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)"
+		return sv.NewSQLStore("sqlite", dsn)
+	}
+	return nil, fmt.Errorf("unknown store %q", cfg.Store.Kind)
+}
+
 func main() {
+	// Read the configuration, rejecting it before anything is started if it
+	// is not valid. -print-config shows it and exits.
+	cfg, cli, err := loadConfig(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	} else if err != nil {
+		log.Fatalf("Failed to read the configuration: %v", err)
+	}
+	if cli.printConfig {
+		if err := cfg.Print(os.Stdout); err != nil {
+			log.Fatalf("Failed to print the configuration: %v", err)
+		}
+	}
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("Invalid configuration:\n%v", err)
+	}
+	if cli.printConfig {
+		return
+	}
+
 	// Register Prometheus metrics
 	prometheus.MustRegister(grpcRequests)
 	prometheus.MustRegister(grpcRequestDuration)
 	prometheus.MustRegister(grpcRequestErrors)
 
 	// Expose Prometheus metrics
-	exposePrometheusMetrics()
+	exposePrometheusMetrics(cfg.Metrics.Address)
+
+	// Open the storage backend
+	store, err := openStore(cfg)
+	if err != nil {
+		log.Fatalf("Failed to open store: %v", err)
+	}
+	defer store.Close()
 
 	// Create a new LibraryServer
-	server := sv.NewLibraryServer()
+	server, err := sv.NewLibraryServer(store, cfg.Watch.HistorySize)
+	if err != nil {
+		log.Fatalf("Failed to create library server: %v", err)
+	}
 
-	// Create a new gRPC server with the Prometheus interceptor
-	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(prometheusUnaryInterceptor),
-	)
+	fees := cfg.FeePolicy()
+	lending := cfg.LendingPolicy()
 
-	// Register the LibraryServer with the gRPC server
+	// Create a new gRPC server with the Prometheus interceptors, followed by
+	// authentication and authorization so that rejected calls are counted too
+	unary := []grpc.UnaryServerInterceptor{prometheusUnaryInterceptor}
+	stream := []grpc.StreamServerInterceptor{prometheusStreamInterceptor}
+	if cfg.Auth.InsecureNoAuth {
+		log.Println("Authentication and authorization are disabled, anyone who can reach the server can change the catalog")
+	} else {
+		auth, err := sv.NewAuthenticator(sv.AuthConfig{
+			JWKSFile:             cfg.Auth.JWKSFile,
+			Issuer:               cfg.Auth.JWTIssuer,
+			Audience:             cfg.Auth.JWTAudience,
+			APIKeysFile:          cfg.Auth.APIKeysFile,
+			ClientIdentitiesFile: cfg.Auth.ClientIdentitiesFile,
+		})
+		if err != nil {
+			log.Fatalf("Failed to set up authentication (use -insecure-no-auth to serve without it): %v", err)
+		}
+		authz, err := sv.NewAuthorizer(cfg.Auth.PolicyFile)
+		if err != nil {
+			log.Fatalf("Failed to load the authorization policy: %v", err)
+		}
+		unary = append(unary, auth.UnaryInterceptor, authz.UnaryInterceptor)
+		stream = append(stream, auth.StreamInterceptor, authz.StreamInterceptor)
+	}
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+		grpc.MaxRecvMsgSize(cfg.GRPC.MaxRecvMessageBytes),
+	}
+	if cfg.TLS.CertFile != "" {
+		tlsConfig, err := sv.NewServerTLSConfig(sv.TLSConfig{
+			CertFile:           cfg.TLS.CertFile,
+			KeyFile:            cfg.TLS.KeyFile,
+			ClientCAFile:       cfg.TLS.ClientCAFile,
+			ClientCertOptional: cfg.TLS.ClientCertOptional,
+		})
+		if err != nil {
+			log.Fatalf("Failed to load TLS certificates: %v", err)
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	} else {
+		log.Println("TLS is disabled, credentials and data are sent in plaintext")
+	}
+	grpcServer := grpc.NewServer(opts...)
+
+	// Register the services with the gRPC server
 	pb.RegisterLibraryServiceServer(grpcServer, server)
+	pb.RegisterAuthorServiceServer(grpcServer, sv.NewAuthorServer(store))
+	pb.RegisterBranchServiceServer(grpcServer, sv.NewBranchServer(store))
+	pb.RegisterMemberServiceServer(grpcServer, sv.NewMemberServer(store))
+	pb.RegisterCopyServiceServer(grpcServer, sv.NewCopyServer(store))
+	pb.RegisterCirculationServiceServer(grpcServer, sv.NewCirculationServer(store, fees, lending))
+	pb.RegisterHoldServiceServer(grpcServer, sv.NewHoldServer(store, fees, lending))
+	pb.RegisterFeeServiceServer(grpcServer, sv.NewFeeServer(store, fees))
 
 	// Listen on a TCP port
-	lis, err := net.Listen("tcp", ":50051")
+	lis, err := net.Listen("tcp", cfg.GRPC.Address)
 	if err != nil {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	log.Printf("Server is listening on port :50051")
+	log.Printf("Server is listening on %s", lis.Addr())
+
+	// Stop gracefully on SIGINT/SIGTERM so the store is closed cleanly
+	go func() {
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+		<-sig
+		log.Println("Shutting down")
+		server.Close()
+		grpcServer.GracefulStop()
+	}()
 
 	// Start serving
 	if err := grpcServer.Serve(lis); err != nil {
-		log.Fatalf("Failed to serve: %v", err)
+		log.Printf("Failed to serve: %v", err)
 	}
 }
@@ -0,0 +1,416 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Metadata keys read by the Authenticator
+const (
+	authorizationHeader = "authorization" // "Bearer <JWT>"
+	apiKeyHeader        = "x-api-key"     // A key from the API keys file
+)
+
+// Ways a Principal can be authenticated
+const (
+	AuthMethodJWT        = "jwt"
+	AuthMethodAPIKey     = "api-key"
+	AuthMethodClientCert = "client-cert"
+)
+
+// Principal is the authenticated caller of an RPC
+type Principal struct {
+	Subject  string   // "sub" claim of the token, or name of the API key or client identity
+	Method   string   // AuthMethodJWT, AuthMethodAPIKey or AuthMethodClientCert
+	Roles    []string // Roles granted by the token, the API key or the client identity
+	MemberID int32    // Member the caller is, from the "member_id" claim of the token; zero if none
+}
+
+// principalKey is the context key of the Principal
+type principalKey struct{}
+
+// PrincipalFromContext returns the caller authenticated by the
+// Authenticator, if any
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok
+}
+
+// withPrincipal returns a context carrying p
+func withPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// AuthConfig selects the credentials accepted by an Authenticator. At least
+// one of the files must be set.
+type AuthConfig struct {
+	JWKSFile             string // JSON Web Key Set with the public keys tokens are signed with
+	Issuer               string // Required "iss" claim of tokens, if set
+	Audience             string // Required "aud" claim of tokens, if set
+	APIKeysFile          string // Lines of "name key [role ...]"
+	ClientIdentitiesFile string // Identities of client certificate subjects, see loadClientIdentities
+}
+
+// apiKey is an entry of the API keys file
+type apiKey struct {
+	name  string
+	roles []string
+}
+
+// parseAPIKeys decodes an API keys file. Blank lines and lines starting
+// with "#" are ignored. Keys are indexed by their SHA-256 so that the
+// lookup does not depend on how much of a key matches.
+func parseAPIKeys(data []byte) (map[[sha256.Size]byte]*apiKey, error) {
+	keys := make(map[[sha256.Size]byte]*apiKey)
+	names := make(map[string]bool)
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; sc.Scan(); line++ {
+		fields := strings.Fields(sc.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("line %d: expected a name and a key", line)
+		}
+		name, key := fields[0], fields[1]
+		if len(key) < 16 {
+			return nil, fmt.Errorf("line %d: key of %s is shorter than 16 characters", line, name)
+		}
+		if names[name] {
+			return nil, fmt.Errorf("line %d: duplicate name %s", line, name)
+		}
+		names[name] = true
+		sum := sha256.Sum256([]byte(key))
+		if _, dup := keys[sum]; dup {
+			return nil, fmt.Errorf("line %d: key of %s is already used", line, name)
+		}
+		keys[sum] = &apiKey{name: name, roles: fields[2:]}
+	}
+	return keys, sc.Err()
+}
+
+// loadAPIKeys reads an API keys file
+func loadAPIKeys(path string) (map[[sha256.Size]byte]*apiKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := parseAPIKeys(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return keys, nil
+}
+
+// reloadCheckInterval limits how often credential files are checked for
+// changes
+const reloadCheckInterval = 5 * time.Second
+
+// watchedFile tracks the modification time of a file so that it is only
+// reread when it changed
+type watchedFile struct {
+	path    string
+	modTime time.Time // Of the version last loaded
+	seen    time.Time // Of the version last found by changed
+	checked time.Time
+}
+
+// changed reports whether the file was modified since it was last loaded,
+// checking at most once per reloadCheckInterval. The new version only counts
+// as loaded once loaded is called, so a failed load is retried at the next
+// check even if the file is not touched again.
+func (f *watchedFile) changed(now time.Time) bool {
+	if now.Sub(f.checked) < reloadCheckInterval {
+		return false
+	}
+	f.checked = now
+	info, err := os.Stat(f.path)
+	if err != nil || info.ModTime().Equal(f.modTime) {
+		return false
+	}
+	f.seen = info.ModTime()
+	return true
+}
+
+// loaded records that the version found by the last call to changed was
+// loaded successfully
+func (f *watchedFile) loaded() {
+	f.modTime = f.seen
+}
+
+// clientIdentity is an entry of the client identities file
+type clientIdentity struct {
+	Subject string   `json:"subject"` // Distinguished name in RFC 2253 form, e.g. "CN=desk-1,O=Library"
+	Name    string   `json:"name"`    // Subject of the Principal, the common name if empty
+	Roles   []string `json:"roles"`
+}
+
+// loadClientIdentities reads a client identities file, a JSON document such
+// as {"identities": [{"subject": "CN=desk-1,O=Library", "roles": ["librarian"]}]}
+func loadClientIdentities(path string) (map[string]*clientIdentity, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var doc struct {
+		Identities []*clientIdentity `json:"identities"`
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	identities := make(map[string]*clientIdentity, len(doc.Identities))
+	for i, id := range doc.Identities {
+		if id.Subject == "" {
+			return nil, fmt.Errorf("%s: identities[%d]: subject is required", path, i)
+		}
+		if _, dup := identities[id.Subject]; dup {
+			return nil, fmt.Errorf("%s: identities[%d]: duplicate subject %s", path, i, id.Subject)
+		}
+		identities[id.Subject] = id
+	}
+	return identities, nil
+}
+
+// credentialFile is a credential file watched for changes, with the
+// function loading it into the Authenticator
+type credentialFile struct {
+	watchedFile
+	load func() error
+}
+
+// Authenticator checks the credentials of incoming RPCs: JWT bearer tokens
+// verified against a local JWKS, static API keys and, on TLS connections,
+// verified client certificates mapped to identities. The credential files
+// are checked for changes every few seconds, so keys can be rotated and
+// revoked without a restart.
+type Authenticator struct {
+	cfg AuthConfig
+	now func() time.Time
+
+	mu         sync.Mutex
+	jwt        *jwtVerifier
+	apiKeys    map[[sha256.Size]byte]*apiKey
+	identities map[string]*clientIdentity
+	files      []*credentialFile
+}
+
+// NewAuthenticator creates an Authenticator accepting the credentials in
+// the files named by cfg. A file that does not exist yet is reported with a
+// warning and its credentials are rejected until it is created, so that a
+// server upgraded from a version without authentication starts, denying
+// every call, instead of failing.
+func NewAuthenticator(cfg AuthConfig) (*Authenticator, error) {
+	if cfg.JWKSFile == "" && cfg.APIKeysFile == "" && cfg.ClientIdentitiesFile == "" {
+		return nil, errors.New("no JWKS, API keys or client identities file configured")
+	}
+	a := &Authenticator{cfg: cfg, now: time.Now}
+	a.watch(cfg.JWKSFile, func() error {
+		keys, err := loadJWKS(cfg.JWKSFile)
+		if err == nil {
+			a.jwt = &jwtVerifier{keys: keys, issuer: cfg.Issuer, audience: cfg.Audience}
+		}
+		return err
+	})
+	a.watch(cfg.APIKeysFile, func() error {
+		keys, err := loadAPIKeys(cfg.APIKeysFile)
+		if err == nil {
+			a.apiKeys = keys
+		}
+		return err
+	})
+	a.watch(cfg.ClientIdentitiesFile, func() error {
+		identities, err := loadClientIdentities(cfg.ClientIdentitiesFile)
+		if err == nil {
+			a.identities = identities
+		}
+		return err
+	})
+	for _, f := range a.files {
+		err := f.load()
+		if errors.Is(err, os.ErrNotExist) {
+			log.Printf("WARNING: %s does not exist, calls with credentials from it are denied until it is created", f.path)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return a, nil
+}
+
+// watch registers a credential file, if configured
+func (a *Authenticator) watch(path string, load func() error) {
+	if path == "" {
+		return
+	}
+	f := &credentialFile{watchedFile: watchedFile{path: path, checked: a.now()}, load: load}
+	if info, err := os.Stat(path); err == nil {
+		f.modTime = info.ModTime()
+	}
+	a.files = append(a.files, f)
+}
+
+// authState is the set of credentials accepted at one point in time
+type authState struct {
+	jwt        *jwtVerifier
+	apiKeys    map[[sha256.Size]byte]*apiKey
+	identities map[string]*clientIdentity
+}
+
+// credentials returns the credentials currently accepted, first rereading
+// the files that changed on disk. A file that fails to load is logged and
+// the previous credentials are kept.
+func (a *Authenticator) credentials() authState {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	now := a.now()
+	for _, f := range a.files {
+		if !f.changed(now) {
+			continue
+		}
+		if err := f.load(); err != nil {
+			log.Printf("Failed to reload %s, keeping the previous credentials: %v", f.path, err)
+		} else {
+			f.loaded()
+			log.Printf("Credentials reloaded from %s", f.path)
+		}
+	}
+	return authState{jwt: a.jwt, apiKeys: a.apiKeys, identities: a.identities}
+}
+
+// verifyToken authenticates a JWT bearer token
+func (a *Authenticator) verifyToken(token string) (*Principal, error) {
+	verifier := a.credentials().jwt
+	if verifier == nil {
+		return nil, errors.New("bearer tokens are not accepted")
+	}
+	claims, err := verifier.verify(token, a.now())
+	if err != nil {
+		return nil, err
+	}
+	return &Principal{Subject: claims.Subject, Method: AuthMethodJWT, Roles: claims.Roles, MemberID: claims.MemberID}, nil
+}
+
+// verifyAPIKey authenticates a static API key
+func (a *Authenticator) verifyAPIKey(key string) (*Principal, error) {
+	keys := a.credentials().apiKeys
+	if keys == nil {
+		return nil, errors.New("API keys are not accepted")
+	}
+	entry, ok := keys[sha256.Sum256([]byte(key))]
+	if !ok {
+		return nil, errors.New("unknown API key")
+	}
+	return &Principal{Subject: entry.name, Method: AuthMethodAPIKey, Roles: entry.roles}, nil
+}
+
+// verifyClientCert authenticates the client certificate of a TLS
+// connection, if one was presented and verified
+func (a *Authenticator) verifyClientCert(ctx context.Context) (*Principal, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 {
+		return nil, nil
+	}
+	cert := info.State.VerifiedChains[0][0]
+	identities := a.credentials().identities
+	if identities == nil {
+		return nil, errors.New("client certificates are not accepted")
+	}
+	id, ok := identities[cert.Subject.String()]
+	if !ok {
+		return nil, fmt.Errorf("client certificate %s is not mapped to an identity", cert.Subject)
+	}
+	name := id.Name
+	if name == "" {
+		name = cert.Subject.CommonName
+	}
+	return &Principal{Subject: name, Method: AuthMethodClientCert, Roles: id.Roles}, nil
+}
+
+// authenticate returns the caller of an RPC from its metadata or, when it
+// carries no credentials, its client certificate
+func (a *Authenticator) authenticate(ctx context.Context) (*Principal, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	auth, keys := md.Get(authorizationHeader), md.Get(apiKeyHeader)
+	switch {
+	case len(auth)+len(keys) == 0:
+		p, err := a.verifyClientCert(ctx)
+		if p == nil && err == nil {
+			err = errors.New("missing credentials")
+		}
+		return p, err
+	case len(auth)+len(keys) > 1:
+		return nil, errors.New("more than one credential")
+	case len(keys) == 1:
+		return a.verifyAPIKey(keys[0])
+	}
+	scheme, token, ok := strings.Cut(auth[0], " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return nil, errors.New("authorization must use the Bearer scheme")
+	}
+	return a.verifyToken(strings.TrimSpace(token))
+}
+
+// check authenticates an RPC and returns its context with the Principal, or
+// an Unauthenticated status error
+func (a *Authenticator) check(ctx context.Context, method string) (context.Context, error) {
+	p, err := a.authenticate(ctx)
+	if err != nil {
+		log.Printf("Unauthenticated call to %s: %v", method, err)
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return withPrincipal(ctx, p), nil
+}
+
+// UnaryInterceptor rejects unary RPCs without valid credentials and passes
+// the Principal to handlers in the context
+func (a *Authenticator) UnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := a.check(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// authenticatedStream is a ServerStream whose context carries the Principal
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context with the Principal
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// StreamInterceptor rejects streaming RPCs without valid credentials and
+// passes the Principal to handlers in the stream context
+func (a *Authenticator) StreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := a.check(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+}
@@ -0,0 +1,373 @@
+package server
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"google.golang.org/grpc/metadata"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testKey is a signing key with the JWK of its public half
+type testKey struct {
+	kid    string
+	alg    string
+	signer crypto.Signer
+}
+
+// b64 encodes b as a base64url JWT segment
+func b64(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// newTestKey generates a key for alg
+func newTestKey(t *testing.T, kid, alg string) *testKey {
+	t.Helper()
+	var signer crypto.Signer
+	var err error
+	switch spec := jwtAlgorithms[alg]; spec.kty {
+	case "RSA":
+		signer, err = rsa.GenerateKey(rand.Reader, 2048)
+	case "EC":
+		signer, err = ecdsa.GenerateKey(spec.curve, rand.Reader)
+	case "OKP":
+		_, signer, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		t.Fatalf("no key type for %s", alg)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testKey{kid: kid, alg: alg, signer: signer}
+}
+
+// jwk returns the public JWK of the key, restricted to its algorithm
+func (k *testKey) jwk() map[string]string {
+	m := map[string]string{"kid": k.kid, "alg": k.alg, "use": "sig"}
+	switch pub := k.signer.Public().(type) {
+	case *rsa.PublicKey:
+		m["kty"], m["n"], m["e"] = "RSA", b64(pub.N.Bytes()), b64(big.NewInt(int64(pub.E)).Bytes())
+	case *ecdsa.PublicKey:
+		m["kty"], m["crv"] = "EC", pub.Curve.Params().Name
+		m["x"], m["y"] = b64(pub.X.Bytes()), b64(pub.Y.Bytes())
+	case ed25519.PublicKey:
+		m["kty"], m["crv"], m["x"] = "OKP", "Ed25519", b64(pub)
+	}
+	return m
+}
+
+// jwksJSON encodes keys as a JWKS document
+func jwksJSON(t *testing.T, keys ...*testKey) []byte {
+	t.Helper()
+	doc := struct {
+		Keys []map[string]string `json:"keys"`
+	}{}
+	for _, k := range keys {
+		doc.Keys = append(doc.Keys, k.jwk())
+	}
+	data, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// sign returns a token with the given header algorithm and claims, signed
+// with the key as that algorithm requires
+func (k *testKey) sign(t *testing.T, alg string, claims map[string]any) string {
+	t.Helper()
+	header, _ := json.Marshal(map[string]string{"alg": alg, "kid": k.kid, "typ": "JWT"})
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed := b64(header) + "." + b64(payload)
+
+	spec := jwtAlgorithms[alg]
+	var sig []byte
+	switch key := k.signer.(type) {
+	case ed25519.PrivateKey:
+		sig = ed25519.Sign(key, []byte(signed))
+	case *rsa.PrivateKey:
+		h := spec.hash.New()
+		h.Write([]byte(signed))
+		if spec.pss {
+			sig, err = rsa.SignPSS(rand.Reader, key, spec.hash, h.Sum(nil), &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		} else {
+			sig, err = rsa.SignPKCS1v15(rand.Reader, key, spec.hash, h.Sum(nil))
+		}
+	case *ecdsa.PrivateKey:
+		h := spec.hash.New()
+		h.Write([]byte(signed))
+		var r, s *big.Int
+		r, s, err = ecdsa.Sign(rand.Reader, key, h.Sum(nil))
+		size := (key.Curve.Params().BitSize + 7) / 8
+		sig = make([]byte, 2*size)
+		r.FillBytes(sig[:size])
+		s.FillBytes(sig[size:])
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed + "." + b64(sig)
+}
+
+func TestJWTVerify(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	rs := newTestKey(t, "rs", "RS256")
+	ps := newTestKey(t, "ps", "PS384")
+	es := newTestKey(t, "es", "ES256")
+	ed := newTestKey(t, "ed", "EdDSA")
+	stranger := newTestKey(t, "rs", "RS256") // Same kid as rs, not in the JWKS
+
+	keys, err := parseJWKS(jwksJSON(t, rs, ps, es, ed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := &jwtVerifier{keys: keys, issuer: "https://id.example", audience: "library"}
+
+	// claims returns valid claims with the given changes, nil deleting one
+	claims := func(changes map[string]any) map[string]any {
+		c := map[string]any{
+			"sub":       "alice",
+			"iss":       "https://id.example",
+			"aud":       "library",
+			"exp":       now.Add(time.Hour).Unix(),
+			"roles":     []string{"librarian"},
+			"member_id": 7,
+		}
+		for k, v := range changes {
+			if v == nil {
+				delete(c, k)
+			} else {
+				c[k] = v
+			}
+		}
+		return c
+	}
+	tampered := func() string {
+		parts := strings.Split(rs.sign(t, "RS256", claims(nil)), ".")
+		payload, _ := json.Marshal(claims(map[string]any{"roles": []string{"admin"}}))
+		return parts[0] + "." + b64(payload) + "." + parts[2]
+	}
+	unsigned := func(alg string) string {
+		header, _ := json.Marshal(map[string]string{"alg": alg})
+		payload, _ := json.Marshal(claims(nil))
+		return b64(header) + "." + b64(payload) + "." + b64([]byte("sig"))
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr string
+	}{
+		{"RS256", rs.sign(t, "RS256", claims(nil)), ""},
+		{"PS384", ps.sign(t, "PS384", claims(nil)), ""},
+		{"ES256", es.sign(t, "ES256", claims(nil)), ""},
+		{"EdDSA", ed.sign(t, "EdDSA", claims(nil)), ""},
+		{"audience array", rs.sign(t, "RS256", claims(map[string]any{"aud": []string{"other", "library"}})), ""},
+		{"expired within leeway", rs.sign(t, "RS256", claims(map[string]any{"exp": now.Add(-jwtLeeway / 2).Unix()})), ""},
+		{"nbf within leeway", rs.sign(t, "RS256", claims(map[string]any{"nbf": now.Add(jwtLeeway / 2).Unix()})), ""},
+
+		{"alg none", unsigned("none"), "unsupported token algorithm"},
+		{"HMAC", unsigned("HS256"), "unsupported token algorithm"},
+		{"alg other than the key's", rs.sign(t, "RS384", claims(nil)), "unknown key"},
+		{"PSS with a PKCS#1 key", rs.sign(t, "PS256", claims(nil)), "unknown key"},
+		{"EC alg with an RSA key", rs.sign(t, "ES256", claims(nil)), "unknown key"},
+		{"unknown signer", stranger.sign(t, "RS256", claims(nil)), "invalid token signature"},
+		{"tampered payload", tampered(), "invalid token signature"},
+		{"malformed", "a.b", "malformed token"},
+		{"expired", rs.sign(t, "RS256", claims(map[string]any{"exp": now.Add(-2 * jwtLeeway).Unix()})), "expired"},
+		{"no expiry", rs.sign(t, "RS256", claims(map[string]any{"exp": nil})), "no expiry"},
+		{"not valid yet", rs.sign(t, "RS256", claims(map[string]any{"nbf": now.Add(2 * jwtLeeway).Unix()})), "not valid yet"},
+		{"wrong issuer", rs.sign(t, "RS256", claims(map[string]any{"iss": "https://evil.example"})), "wrong issuer"},
+		{"no issuer", rs.sign(t, "RS256", claims(map[string]any{"iss": nil})), "wrong issuer"},
+		{"wrong audience", rs.sign(t, "RS256", claims(map[string]any{"aud": "billing"})), "not meant for this server"},
+		{"no subject", rs.sign(t, "RS256", claims(map[string]any{"sub": nil})), "no subject"},
+		{"member ID not a number", rs.sign(t, "RS256", claims(map[string]any{"member_id": "seven"})), "malformed token claims"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := v.verify(tc.token, now)
+			switch {
+			case tc.wantErr == "" && err != nil:
+				t.Errorf("verify: %v", err)
+			case tc.wantErr == "" && (got.Subject != "alice" || len(got.Roles) != 1 || got.Roles[0] != "librarian" || got.MemberID != 7):
+				t.Errorf("verify returned %+v", got)
+			case tc.wantErr != "" && err == nil:
+				t.Errorf("verify succeeded, want an error containing %q", tc.wantErr)
+			case tc.wantErr != "" && !strings.Contains(err.Error(), tc.wantErr):
+				t.Errorf("verify: %v, want an error containing %q", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseJWKS(t *testing.T) {
+	tests := []struct {
+		name    string
+		jwks    string
+		wantErr bool
+	}{
+		{"empty", `{"keys": []}`, true},
+		{"only encryption keys", `{"keys": [{"kty": "RSA", "use": "enc"}]}`, true},
+		{"short RSA key", `{"keys": [{"kty": "RSA", "n": "AQAB", "e": "AQAB"}]}`, true},
+		{"alg of another key type", `{"keys": [{"kty": "OKP", "crv": "Ed25519", "alg": "RS256", "x": "` + b64(make([]byte, 32)) + `"}]}`, true},
+		{"unknown alg", `{"keys": [{"kty": "OKP", "crv": "Ed25519", "alg": "HS256", "x": "` + b64(make([]byte, 32)) + `"}]}`, true},
+		{"point off the curve", `{"keys": [{"kty": "EC", "crv": "P-256", "x": "AQ", "y": "AQ"}]}`, true},
+		{"Ed25519", `{"keys": [{"kty": "OKP", "crv": "Ed25519", "x": "` + b64(make([]byte, 32)) + `"}]}`, false},
+	}
+	for _, tc := range tests {
+		if _, err := parseJWKS([]byte(tc.jwks)); (err != nil) != tc.wantErr {
+			t.Errorf("%s: got error %v, want error %v", tc.name, err, tc.wantErr)
+		}
+	}
+}
+
+func TestParseAPIKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		wantErr string
+	}{
+		{"valid", "# comment\n\ndesk-1 0123456789abcdef librarian\nkiosk fedcba9876543210\n", ""},
+		{"missing key", "desk-1\n", "expected a name and a key"},
+		{"short key", "desk-1 0123456789\n", "shorter than 16"},
+		{"duplicate name", "desk-1 0123456789abcdef\ndesk-1 fedcba9876543210\n", "duplicate name"},
+		{"duplicate key", "desk-1 0123456789abcdef\ndesk-2 0123456789abcdef\n", "already used"},
+	}
+	for _, tc := range tests {
+		_, err := parseAPIKeys([]byte(tc.file))
+		switch {
+		case tc.wantErr == "" && err != nil:
+			t.Errorf("%s: %v", tc.name, err)
+		case tc.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tc.wantErr)):
+			t.Errorf("%s: got %v, want an error containing %q", tc.name, err, tc.wantErr)
+		}
+	}
+}
+
+// authWith returns a context carrying the given metadata
+func authWith(kv ...string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(kv...))
+}
+
+func TestAuthenticatorAPIKeys(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "api-keys")
+	if err := os.WriteFile(path, []byte("desk-1 0123456789abcdef librarian admin\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	a, err := NewAuthenticator(AuthConfig{APIKeysFile: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := a.authenticate(authWith(apiKeyHeader, "0123456789abcdef"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Subject != "desk-1" || p.Method != AuthMethodAPIKey || strings.Join(p.Roles, " ") != "librarian admin" {
+		t.Errorf("got principal %+v", p)
+	}
+	for _, key := range []string{"0123456789abcde", "0123456789abcdef0", "0123456789ABCDEF", ""} {
+		if _, err := a.authenticate(authWith(apiKeyHeader, key)); err == nil {
+			t.Errorf("key %q was accepted", key)
+		}
+	}
+	if _, err := a.authenticate(authWith(apiKeyHeader, "0123456789abcdef", apiKeyHeader, "0123456789abcdef")); err == nil {
+		t.Error("two keys were accepted")
+	}
+	if _, err := a.authenticate(authWith(authorizationHeader, "Bearer x.y.z")); err == nil {
+		t.Error("a token was accepted without a JWKS")
+	}
+	if _, err := a.authenticate(context.Background()); err == nil {
+		t.Error("a call without credentials was accepted")
+	}
+}
+
+func TestAuthenticatorMissingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "api-keys")
+	a, err := NewAuthenticator(AuthConfig{APIKeysFile: path})
+	if err != nil {
+		t.Fatalf("missing API keys file: %v", err)
+	}
+	now := a.files[0].checked
+	a.now = func() time.Time { return now }
+	ctx := authWith(apiKeyHeader, "0123456789abcdef")
+	if _, err := a.authenticate(ctx); err == nil {
+		t.Error("a key was accepted without an API keys file")
+	}
+
+	// The keys are accepted once the file is created
+	if err := os.WriteFile(path, []byte("desk-1 0123456789abcdef librarian\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(reloadCheckInterval)
+	if _, err := a.authenticate(ctx); err != nil {
+		t.Errorf("after creating the API keys file: %v", err)
+	}
+}
+
+func TestAuthenticatorKeyRotation(t *testing.T) {
+	dir := t.TempDir()
+	jwksPath := filepath.Join(dir, "jwks.json")
+	write := func(data []byte, modTime time.Time) {
+		t.Helper()
+		if err := os.WriteFile(jwksPath, data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(jwksPath, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	old, next := newTestKey(t, "2026-01", "ES256"), newTestKey(t, "2026-02", "ES256")
+	start := time.Now()
+	write(jwksJSON(t, old), start)
+
+	a, err := NewAuthenticator(AuthConfig{JWKSFile: jwksPath})
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := a.files[0].checked
+	a.now = func() time.Time { return now }
+	token := func(k *testKey) context.Context {
+		return authWith(authorizationHeader, "Bearer "+k.sign(t, "ES256", map[string]any{
+			"sub": "alice", "exp": now.Add(time.Hour).Unix(),
+		}))
+	}
+	check := func(stage string, k *testKey, accepted bool) {
+		t.Helper()
+		if _, err := a.authenticate(token(k)); (err == nil) != accepted {
+			t.Errorf("%s: key %s accepted %v, want %v (%v)", stage, k.kid, err == nil, accepted, err)
+		}
+	}
+	check("before the rotation", old, true)
+	check("before the rotation", next, false)
+
+	// Both keys are published during the rotation, then the old one is
+	// withdrawn
+	write(jwksJSON(t, old, next), start.Add(time.Second))
+	now = now.Add(reloadCheckInterval)
+	check("during the rotation", old, true)
+	check("during the rotation", next, true)
+
+	write(jwksJSON(t, next), start.Add(2*time.Second))
+	now = now.Add(reloadCheckInterval)
+	check("after the rotation", old, false)
+	check("after the rotation", next, true)
+
+	// A broken JWKS keeps the previous keys
+	write([]byte(`{"keys": [`), start.Add(3*time.Second))
+	now = now.Add(reloadCheckInterval)
+	check("after a failed reload", next, true)
+}
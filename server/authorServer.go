@@ -0,0 +1,594 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"log"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// AuthorServer is used to implement the AuthorService
+type AuthorServer struct {
+	pb.UnimplementedAuthorServiceServer
+	store Store
+}
+
+// NewAuthorServer creates an AuthorServer backed by the given Store
+func NewAuthorServer(store Store) *AuthorServer {
+	return &AuthorServer{store: store}
+}
+
+// authorKey returns the form of a name used to recognize the same author
+// spelled differently: "Last, First" is turned around, case and punctuation
+// are ignored and initials are split, so "Tolkien, J.R.R." and
+// "J. R. R. Tolkien" both give "j r r tolkien"
+func authorKey(name string) string {
+	if last, first, ok := strings.Cut(name, ","); ok && !strings.Contains(first, ",") {
+		name = first + " " + last
+	}
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '\''
+	})
+	return strings.Join(words, " ")
+}
+
+// splitAuthorNames splits a free-text author into the names it lists.
+// Names are separated by ";", "&" or "and"; commas separate names only when
+// there are several, a single comma being read as "Last, First".
+func splitAuthorNames(s string) []string {
+	var names []string
+	for _, part := range strings.FieldsFunc(s, func(r rune) bool { return r == ';' || r == '&' }) {
+		for _, name := range splitWord(part, "and") {
+			if strings.Count(name, ",") > 1 {
+				names = append(names, strings.Split(name, ",")...)
+			} else {
+				names = append(names, name)
+			}
+		}
+	}
+	trimmed := names[:0]
+	for _, name := range names {
+		if name = strings.TrimSpace(name); name != "" {
+			trimmed = append(trimmed, name)
+		}
+	}
+	return trimmed
+}
+
+// splitWord splits s around every occurrence of word as a whole word,
+// ignoring case
+func splitWord(s, word string) []string {
+	var parts []string
+	fields := strings.Fields(s)
+	start := 0
+	for i, f := range fields {
+		if strings.EqualFold(f, word) {
+			parts = append(parts, strings.Join(fields[start:i], " "))
+			start = i + 1
+		}
+	}
+	return append(parts, strings.Join(fields[start:], " "))
+}
+
+// linkAuthors validates the author links of a book within tx and, when it
+// has AUTHOR links, derives its free-text author from their names, which
+// the filters, ordering and search of books read
+func linkAuthors(ctx context.Context, tx Tx, book *pb.Book) error {
+	type linkKey struct {
+		id   int32
+		role pb.BookAuthor_Role
+	}
+	seen := make(map[linkKey]bool, len(book.Authors))
+	var names []string
+	for i, link := range book.Authors {
+		field := fmt.Sprintf("book.authors[%d]", i)
+		if _, ok := pb.BookAuthor_Role_name[int32(link.Role)]; !ok {
+			return fieldError(codes.InvalidArgument, field+".role", "unknown role")
+		}
+		if link.Role == pb.BookAuthor_ROLE_UNSPECIFIED {
+			link.Role = pb.BookAuthor_AUTHOR
+		}
+		key := linkKey{link.AuthorId, link.Role}
+		if seen[key] {
+			return fieldError(codes.InvalidArgument, field, "author is already linked with this role")
+		}
+		seen[key] = true
+
+		author, err := tx.GetAuthor(ctx, link.AuthorId)
+		if errors.Is(err, ErrNotFound) {
+			return fieldError(codes.InvalidArgument, field+".author_id", authorName(link.AuthorId)+" not found")
+		} else if err != nil {
+			return err
+		}
+		if link.Role == pb.BookAuthor_AUTHOR {
+			names = append(names, author.Name)
+		}
+	}
+	if len(names) > 0 {
+		book.Author = strings.Join(names, ", ")
+	}
+	return nil
+}
+
+// refreshAuthors derives again the free-text author of the books linked to
+// an author within tx, after it was renamed
+func refreshAuthors(ctx context.Context, tx Tx, authorID int32) error {
+	books, err := tx.ListBooksByAuthor(ctx, authorID)
+	if err != nil {
+		return err
+	}
+	for _, book := range books {
+		prev := book.Author
+		if err := linkAuthors(ctx, tx, book); err != nil {
+			return err
+		}
+		if book.Author == prev {
+			continue
+		}
+		if err := tx.UpdateBook(ctx, book); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addAliases adds the names that are not yet the name or an alias of the
+// author, and reports whether any was added
+func addAliases(author *pb.Author, names ...string) bool {
+	known := map[string]bool{author.Name: true}
+	for _, alias := range author.Aliases {
+		known[alias] = true
+	}
+	added := false
+	for _, name := range names {
+		if name != "" && !known[name] {
+			known[name] = true
+			author.Aliases = append(author.Aliases, name)
+			added = true
+		}
+	}
+	return added
+}
+
+// prepareAuthor validates an author sent by a client and returns a
+// normalized copy
+func prepareAuthor(author *pb.Author) (*pb.Author, error) {
+	if author == nil {
+		return nil, fieldError(codes.InvalidArgument, "author", "author is required")
+	}
+	author = proto.Clone(author).(*pb.Author)
+	if author.Name = strings.TrimSpace(author.Name); author.Name == "" {
+		return nil, fieldError(codes.InvalidArgument, "author.name", "name is required")
+	}
+	aliases := author.Aliases
+	author.Aliases = nil
+	for i, alias := range aliases {
+		if strings.ContainsRune(alias, '\n') {
+			return nil, fieldError(codes.InvalidArgument, fmt.Sprintf("author.aliases[%d]", i), "alias must be a single line")
+		}
+		addAliases(author, strings.TrimSpace(alias))
+	}
+	author.Bio = strings.TrimSpace(author.Bio)
+	return author, nil
+}
+
+// loadAuthor reads an author within tx and checks its revision unless
+// expected is zero
+func loadAuthor(ctx context.Context, tx Tx, id int32, expected int64) (*pb.Author, error) {
+	author, err := tx.GetAuthor(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if expected != 0 && author.Revision != expected {
+		return nil, ErrRevisionMismatch
+	}
+	return author, nil
+}
+
+// CreateAuthor implementation. The server assigns the next ID when the
+// request leaves it empty.
+func (s *AuthorServer) CreateAuthor(ctx context.Context, req *pb.CreateAuthorRequest) (*pb.CreateAuthorResponse, error) {
+	author, err := prepareAuthor(req.Author)
+	if err != nil {
+		return nil, err
+	}
+	err = s.store.RunInTx(ctx, func(tx Tx) error {
+		if author.Id == 0 {
+			id, err := tx.NextAuthorID(ctx)
+			if err != nil {
+				return err
+			}
+			author.Id = id
+		}
+		return tx.CreateAuthor(ctx, author)
+	})
+	if err != nil {
+		return nil, authorError(err, author.Id)
+	}
+	log.Printf("Author added: %d %s", author.Id, author.Name)
+
+	return &pb.CreateAuthorResponse{Author: author}, nil
+}
+
+// GetAuthor implementation
+func (s *AuthorServer) GetAuthor(ctx context.Context, req *pb.GetAuthorRequest) (*pb.GetAuthorResponse, error) {
+	var author *pb.Author
+	err := s.store.RunInTx(ctx, func(tx Tx) (err error) {
+		author, err = tx.GetAuthor(ctx, req.Id)
+		return err
+	})
+	if err != nil {
+		return nil, authorError(err, req.Id)
+	}
+
+	return &pb.GetAuthorResponse{Author: author}, nil
+}
+
+// UpdateAuthor implementation. A new name is carried over to the author of
+// the linked books in the same transaction.
+func (s *AuthorServer) UpdateAuthor(ctx context.Context, req *pb.UpdateAuthorRequest) (*pb.UpdateAuthorResponse, error) {
+	author, err := prepareAuthor(req.Author)
+	if err != nil {
+		return nil, err
+	}
+	err = s.store.RunInTx(ctx, func(tx Tx) error {
+		prev, err := loadAuthor(ctx, tx, author.Id, author.Revision)
+		if err != nil {
+			return err
+		}
+		if err := tx.UpdateAuthor(ctx, author); err != nil {
+			return err
+		}
+		if author.Name != prev.Name {
+			return refreshAuthors(ctx, tx, author.Id)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, authorError(err, author.Id)
+	}
+	log.Printf("Author updated: %d", author.Id)
+
+	return &pb.UpdateAuthorResponse{Author: author}, nil
+}
+
+// DeleteAuthor implementation
+func (s *AuthorServer) DeleteAuthor(ctx context.Context, req *pb.DeleteAuthorRequest) (*pb.DeleteAuthorResponse, error) {
+	err := s.store.RunInTx(ctx, func(tx Tx) error {
+		if _, err := loadAuthor(ctx, tx, req.Id, req.Revision); err != nil {
+			return err
+		}
+		books, err := tx.ListBooksByAuthor(ctx, req.Id)
+		if err != nil {
+			return err
+		}
+		if len(books) > 0 {
+			return preconditionError("AUTHOR_HAS_BOOKS", authorName(req.Id),
+				fmt.Sprintf("%d books are still linked to this author", len(books)))
+		}
+		return tx.DeleteAuthor(ctx, req.Id)
+	})
+	if err != nil {
+		return nil, authorError(err, req.Id)
+	}
+	log.Printf("Author deleted: %d", req.Id)
+
+	return &pb.DeleteAuthorResponse{Success: true}, nil
+}
+
+// matchesAuthor reports whether the name or an alias of the author contains
+// the words of key in order, the first one as a word prefix
+func matchesAuthor(author *pb.Author, key string) bool {
+	for _, name := range append([]string{author.Name}, author.Aliases...) {
+		if strings.Contains(" "+authorKey(name), " "+key) {
+			return true
+		}
+	}
+	return false
+}
+
+// ListAuthors implementation
+func (s *AuthorServer) ListAuthors(ctx context.Context, req *pb.ListAuthorsRequest) (*pb.ListAuthorsResponse, error) {
+	pageSize, err := resolvePageSize("page_size", req.PageSize, defaultPageSize, maxPageSize)
+	if err != nil {
+		return nil, err
+	}
+	var after int32
+	if req.PageToken != "" {
+		if after, err = decodeIDPageToken(req.PageToken); err != nil {
+			return nil, fieldError(codes.InvalidArgument, "page_token", err.Error())
+		}
+	}
+
+	var authors []*pb.Author
+	err = s.store.RunInTx(ctx, func(tx Tx) (err error) {
+		authors, err = tx.ListAuthors(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, toStatus(err, authorResourceType, "authors")
+	}
+
+	key := authorKey(req.Query)
+	matched := authors[:0]
+	for _, a := range authors {
+		if key == "" || matchesAuthor(a, key) {
+			matched = append(matched, a)
+		}
+	}
+	start := sort.Search(len(matched), func(i int) bool { return matched[i].Id > after })
+	end := min(start+pageSize, len(matched))
+
+	resp := &pb.ListAuthorsResponse{
+		Authors:   matched[start:end],
+		TotalSize: int32(len(matched)),
+	}
+	if end < len(matched) {
+		resp.NextPageToken = encodeIDPageToken(matched[end-1].Id)
+	}
+	return resp, nil
+}
+
+// ListAuthorBooks implementation
+func (s *AuthorServer) ListAuthorBooks(ctx context.Context, req *pb.ListAuthorBooksRequest) (*pb.ListAuthorBooksResponse, error) {
+	pageSize, err := resolvePageSize("page_size", req.PageSize, defaultPageSize, maxPageSize)
+	if err != nil {
+		return nil, err
+	}
+	var after int32
+	if req.PageToken != "" {
+		if after, err = decodeIDPageToken(req.PageToken); err != nil {
+			return nil, fieldError(codes.InvalidArgument, "page_token", err.Error())
+		}
+	}
+
+	var books []*pb.Book
+	err = s.store.RunInTx(ctx, func(tx Tx) (err error) {
+		if _, err := tx.GetAuthor(ctx, req.AuthorId); err != nil {
+			return err
+		}
+		books, err = tx.ListBooksByAuthor(ctx, req.AuthorId)
+		return err
+	})
+	if err != nil {
+		return nil, authorError(err, req.AuthorId)
+	}
+
+	matched := books[:0]
+	for _, book := range books {
+		for _, link := range book.Authors {
+			if link.AuthorId == req.AuthorId && (req.Role == pb.BookAuthor_ROLE_UNSPECIFIED || link.Role == req.Role) {
+				matched = append(matched, book)
+				break
+			}
+		}
+	}
+	start := sort.Search(len(matched), func(i int) bool { return matched[i].Id > after })
+	end := min(start+pageSize, len(matched))
+
+	resp := &pb.ListAuthorBooksResponse{
+		Books:     matched[start:end],
+		TotalSize: int32(len(matched)),
+	}
+	if end < len(matched) {
+		resp.NextPageToken = encodeIDPageToken(matched[end-1].Id)
+	}
+	return resp, nil
+}
+
+// relinkBook points the links of a book to from at to instead, dropping
+// links that would then repeat
+func relinkBook(book *pb.Book, from, to int32) {
+	type linkKey struct {
+		id   int32
+		role pb.BookAuthor_Role
+	}
+	seen := make(map[linkKey]bool, len(book.Authors))
+	links := book.Authors[:0]
+	for _, link := range book.Authors {
+		if link.AuthorId == from {
+			link.AuthorId = to
+		}
+		if key := (linkKey{link.AuthorId, link.Role}); !seen[key] {
+			seen[key] = true
+			links = append(links, link)
+		}
+	}
+	book.Authors = links
+}
+
+// MergeAuthors implementation. Books are relinked and the duplicates
+// deleted in one transaction; their names are kept as aliases of the target.
+func (s *AuthorServer) MergeAuthors(ctx context.Context, req *pb.MergeAuthorsRequest) (*pb.MergeAuthorsResponse, error) {
+	if len(req.SourceIds) == 0 {
+		return nil, fieldError(codes.InvalidArgument, "source_ids", "at least one source is required")
+	}
+	for i, id := range req.SourceIds {
+		if id == req.TargetId {
+			return nil, fieldError(codes.InvalidArgument, fmt.Sprintf("source_ids[%d]", i), "cannot merge an author into itself")
+		}
+	}
+
+	var target *pb.Author
+	var relinked int32
+	err := s.store.RunInTx(ctx, func(tx Tx) (err error) {
+		if target, err = tx.GetAuthor(ctx, req.TargetId); err != nil {
+			return authorError(err, req.TargetId)
+		}
+		merged := make(map[int32]bool, len(req.SourceIds))
+		for _, id := range req.SourceIds {
+			if merged[id] {
+				continue
+			}
+			merged[id] = true
+			source, err := tx.GetAuthor(ctx, id)
+			if err != nil {
+				return authorError(err, id)
+			}
+			books, err := tx.ListBooksByAuthor(ctx, id)
+			if err != nil {
+				return err
+			}
+			for _, book := range books {
+				relinkBook(book, id, target.Id)
+				if err := linkAuthors(ctx, tx, book); err != nil {
+					return err
+				}
+				if err := tx.UpdateBook(ctx, book); err != nil {
+					return err
+				}
+				relinked++
+			}
+			addAliases(target, append([]string{source.Name}, source.Aliases...)...)
+			if err := tx.DeleteAuthor(ctx, id); err != nil {
+				return err
+			}
+		}
+		return tx.UpdateAuthor(ctx, target)
+	})
+	if err != nil {
+		return nil, toStatus(err, authorResourceType, "authors")
+	}
+	log.Printf("Authors %v merged into %d, %d books relinked", req.SourceIds, target.Id, relinked)
+
+	return &pb.MergeAuthorsResponse{Author: target, BooksRelinked: relinked}, nil
+}
+
+// authorGroup collects the spellings of one author found in free-text
+// authors
+type authorGroup struct {
+	key     string
+	group   *pb.AuthorGroup
+	counts  map[string]int // Books per spelling
+	changed bool           // Whether an existing author gained aliases
+}
+
+// DeduplicateAuthors implementation. Every book without author links gets
+// one AUTHOR link per name in its free-text author. Names are matched to
+// existing authors, by name or alias, with authorKey; the remaining ones
+// are grouped the same way and one author is created per group, named after
+// its most frequent spelling. The free-text author of the linked books is
+// then derived from the names of their authors. Books that already have
+// links are left alone, so the migration can be run again after new books
+// are added.
+func (s *AuthorServer) DeduplicateAuthors(ctx context.Context, req *pb.DeduplicateAuthorsRequest) (*pb.DeduplicateAuthorsResponse, error) {
+	resp := &pb.DeduplicateAuthorsResponse{}
+	err := s.store.RunInTx(ctx, func(tx Tx) error {
+		authors, err := tx.ListAuthors(ctx)
+		if err != nil {
+			return err
+		}
+		known := make(map[string]*pb.Author)
+		for _, a := range authors {
+			for _, name := range append([]string{a.Name}, a.Aliases...) {
+				if k := authorKey(name); k != "" && known[k] == nil {
+					known[k] = a
+				}
+			}
+		}
+
+		books, err := tx.ListBooks(ctx)
+		if err != nil {
+			return err
+		}
+		groups := make(map[string]*authorGroup)
+		var pending []*pb.Book
+		bookKeys := make(map[int32][]string)
+		for _, book := range books {
+			if len(book.Authors) > 0 || strings.TrimSpace(book.Author) == "" {
+				continue
+			}
+			for _, name := range splitAuthorNames(book.Author) {
+				key := authorKey(name)
+				if key == "" || slices.Contains(bookKeys[book.Id], key) {
+					continue
+				}
+				g := groups[key]
+				if g == nil {
+					g = &authorGroup{key: key, group: &pb.AuthorGroup{Author: known[key]}, counts: make(map[string]int)}
+					groups[key] = g
+				}
+				if g.counts[name] == 0 {
+					g.group.Variants = append(g.group.Variants, name)
+				}
+				g.counts[name]++
+				g.group.BookIds = append(g.group.BookIds, book.Id)
+				bookKeys[book.Id] = append(bookKeys[book.Id], key)
+			}
+			if len(bookKeys[book.Id]) > 0 {
+				pending = append(pending, book)
+			}
+		}
+
+		keys := make([]string, 0, len(groups))
+		for key := range groups {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			g := groups[key]
+			if g.group.Author == nil {
+				name := g.group.Variants[0]
+				for _, v := range g.group.Variants {
+					if g.counts[v] > g.counts[name] {
+						name = v
+					}
+				}
+				g.group.Author = &pb.Author{Name: name}
+				addAliases(g.group.Author, g.group.Variants...)
+				g.group.Created = true
+				resp.AuthorsCreated++
+			} else {
+				g.changed = addAliases(g.group.Author, g.group.Variants...)
+			}
+			resp.Groups = append(resp.Groups, g.group)
+		}
+		resp.BooksLinked = int32(len(pending))
+		if req.DryRun {
+			return nil
+		}
+
+		for _, key := range keys {
+			g := groups[key]
+			switch {
+			case g.group.Created:
+				if g.group.Author.Id, err = tx.NextAuthorID(ctx); err != nil {
+					return err
+				}
+				err = tx.CreateAuthor(ctx, g.group.Author)
+			case g.changed:
+				err = tx.UpdateAuthor(ctx, g.group.Author)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		for _, book := range pending {
+			for _, key := range bookKeys[book.Id] {
+				book.Authors = append(book.Authors, &pb.BookAuthor{AuthorId: groups[key].group.Author.Id, Role: pb.BookAuthor_AUTHOR})
+			}
+			if err := linkAuthors(ctx, tx, book); err != nil {
+				return err
+			}
+			if err := tx.UpdateBook(ctx, book); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, toStatus(err, authorResourceType, "authors")
+	}
+	if !req.DryRun {
+		log.Printf("Authors deduplicated: %d created, %d books linked", resp.AuthorsCreated, resp.BooksLinked)
+	}
+
+	return resp, nil
+}
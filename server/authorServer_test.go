@@ -0,0 +1,80 @@
+package server
+
+import (
+	"context"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"testing"
+)
+
+// searchIDs returns the IDs of the books an exact search finds
+func searchIDs(t *testing.T, s *LibraryServer, query string) []int32 {
+	t.Helper()
+	resp, err := s.SearchBooks(context.Background(), &pb.SearchBooksRequest{Query: query, Exact: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []int32
+	for _, hit := range resp.Hits {
+		ids = append(ids, hit.Book.Id)
+	}
+	return ids
+}
+
+func TestAuthorChangesRefreshBooks(t *testing.T) {
+	ctx := context.Background()
+	store := NewBookStore()
+	books, err := NewLibraryServer(store, DefaultWatchHistory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	authors := NewAuthorServer(store)
+
+	var ids []int32
+	for _, name := range []string{"Tolkien, J.R.R.", "Christopher Tolkien"} {
+		resp, err := authors.CreateAuthor(ctx, &pb.CreateAuthorRequest{Author: &pb.Author{Name: name}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, resp.Author.Id)
+	}
+	created, err := books.CreateBook(ctx, &pb.CreateBookRequest{Book: &pb.Book{
+		Title:   "The Hobbit",
+		Author:  "typed by hand",
+		Authors: []*pb.BookAuthor{{AuthorId: ids[0]}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	bookID := created.Book.Id
+	author := func() string {
+		t.Helper()
+		resp, err := books.GetBook(ctx, &pb.GetBookRequest{Id: bookID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.Book.Author
+	}
+	if got := author(); got != "Tolkien, J.R.R." {
+		t.Errorf("author derived on create: got %q", got)
+	}
+
+	if _, err := authors.UpdateAuthor(ctx, &pb.UpdateAuthorRequest{Author: &pb.Author{Id: ids[0], Name: "J. R. R. Tolkien"}}); err != nil {
+		t.Fatal(err)
+	}
+	if got := author(); got != "J. R. R. Tolkien" {
+		t.Errorf("author after a rename: got %q", got)
+	}
+	if got := searchIDs(t, books, "R"); len(got) != 1 || got[0] != bookID {
+		t.Errorf("search for the new name found %v", got)
+	}
+
+	if _, err := authors.MergeAuthors(ctx, &pb.MergeAuthorsRequest{TargetId: ids[1], SourceIds: []int32{ids[0]}}); err != nil {
+		t.Fatal(err)
+	}
+	if got := author(); got != "Christopher Tolkien" {
+		t.Errorf("author after a merge: got %q", got)
+	}
+	if got := searchIDs(t, books, "Christopher"); len(got) != 1 || got[0] != bookID {
+		t.Errorf("search for the merged name found %v", got)
+	}
+}
@@ -0,0 +1,207 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"google.golang.org/protobuf/proto"
+	"sort"
+	"strings"
+)
+
+// linksAuthor reports whether a book is linked to an author
+func linksAuthor(book *pb.Book, authorID int32) bool {
+	for _, a := range book.Authors {
+		if a.AuthorId == authorID {
+			return true
+		}
+	}
+	return false
+}
+
+// In-memory implementation of AuthorTx
+
+// CreateAuthor adds a new author, failing if its ID is already taken
+func (tx *memTx) CreateAuthor(ctx context.Context, author *pb.Author) error {
+	if _, exists := tx.s.authors.rows[author.Id]; exists {
+		return ErrAlreadyExists
+	}
+	author.Revision = 1
+	tx.s.authors.put(tx, author)
+	return nil
+}
+
+// GetAuthor returns a copy of the author with the given ID
+func (tx *memTx) GetAuthor(ctx context.Context, id int32) (*pb.Author, error) {
+	return tx.s.authors.get(id)
+}
+
+// UpdateAuthor replaces an existing author
+func (tx *memTx) UpdateAuthor(ctx context.Context, author *pb.Author) error {
+	prev, exists := tx.s.authors.rows[author.Id]
+	if !exists {
+		return ErrNotFound
+	}
+	author.Revision = prev.Revision + 1
+	tx.s.authors.put(tx, author)
+	return nil
+}
+
+// DeleteAuthor removes the author with the given ID
+func (tx *memTx) DeleteAuthor(ctx context.Context, id int32) error {
+	return tx.s.authors.delete(tx, id)
+}
+
+// ListAuthors returns a copy of every author ordered by ID
+func (tx *memTx) ListAuthors(ctx context.Context) ([]*pb.Author, error) {
+	return tx.s.authors.list(), nil
+}
+
+// NextAuthorID allocates the next unused author ID
+func (tx *memTx) NextAuthorID(ctx context.Context) (int32, error) {
+	return tx.s.authors.nextID(tx)
+}
+
+// ListBooksByAuthor returns a copy of the books linked to an author
+func (tx *memTx) ListBooksByAuthor(ctx context.Context, authorID int32) ([]*pb.Book, error) {
+	var books []*pb.Book
+	for _, book := range tx.s.books {
+		if linksAuthor(book, authorID) {
+			books = append(books, proto.Clone(book).(*pb.Book))
+		}
+	}
+	sort.Slice(books, func(i, j int) bool { return books[i].Id < books[j].Id })
+	return books, nil
+}
+
+// SQL implementation of AuthorTx
+
+const authorColumns = `id, name, aliases, bio, revision`
+
+// scanAuthor reads a row selected with authorColumns
+func scanAuthor(row scanner) (*pb.Author, error) {
+	author := &pb.Author{}
+	var aliases string
+	err := row.Scan(&author.Id, &author.Name, &aliases, &author.Bio, &author.Revision)
+	if aliases != "" {
+		author.Aliases = strings.Split(aliases, "\n")
+	}
+	return author, err
+}
+
+// CreateAuthor implementation
+func (tx *sqlTx) CreateAuthor(ctx context.Context, author *pb.Author) error {
+	if _, err := tx.GetAuthor(ctx, author.Id); err == nil {
+		return ErrAlreadyExists
+	} else if !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	if _, err := tx.q.ExecContext(ctx,
+		`INSERT INTO authors (`+authorColumns+`) VALUES (?, ?, ?, ?, 1)`,
+		author.Id, author.Name, strings.Join(author.Aliases, "\n"), author.Bio); err != nil {
+		return err
+	}
+	author.Revision = 1
+	return tx.bumpSequence(ctx, "authors", author.Id)
+}
+
+// GetAuthor implementation
+func (tx *sqlTx) GetAuthor(ctx context.Context, id int32) (*pb.Author, error) {
+	author, err := scanAuthor(tx.q.QueryRowContext(ctx, `SELECT `+authorColumns+` FROM authors WHERE id = ?`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return author, err
+}
+
+// UpdateAuthor implementation
+func (tx *sqlTx) UpdateAuthor(ctx context.Context, author *pb.Author) error {
+	err := tx.q.QueryRowContext(ctx,
+		`UPDATE authors SET name = ?, aliases = ?, bio = ?, revision = revision + 1 WHERE id = ? RETURNING revision`,
+		author.Name, strings.Join(author.Aliases, "\n"), author.Bio, author.Id).Scan(&author.Revision)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// DeleteAuthor implementation
+func (tx *sqlTx) DeleteAuthor(ctx context.Context, id int32) error {
+	res, err := tx.q.ExecContext(ctx, `DELETE FROM authors WHERE id = ?`, id)
+	if err != nil {
+		return err
+	}
+	return checkAffected(res)
+}
+
+// ListAuthors implementation
+func (tx *sqlTx) ListAuthors(ctx context.Context) ([]*pb.Author, error) {
+	rows, err := tx.q.QueryContext(ctx, `SELECT `+authorColumns+` FROM authors ORDER BY id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var authors []*pb.Author
+	for rows.Next() {
+		author, err := scanAuthor(rows)
+		if err != nil {
+			return nil, err
+		}
+		authors = append(authors, author)
+	}
+	return authors, rows.Err()
+}
+
+// NextAuthorID implementation
+func (tx *sqlTx) NextAuthorID(ctx context.Context) (int32, error) {
+	return tx.nextID(ctx, "authors", "authors")
+}
+
+// ListBooksByAuthor implementation
+func (tx *sqlTx) ListBooksByAuthor(ctx context.Context, authorID int32) ([]*pb.Book, error) {
+	return tx.queryBooks(ctx, `SELECT `+bookColumns+` FROM books
+		WHERE id IN (SELECT book_id FROM book_authors WHERE author_id = ?) ORDER BY id`, authorID)
+}
+
+// saveBookAuthors replaces the stored author links of a book
+func (tx *sqlTx) saveBookAuthors(ctx context.Context, book *pb.Book) error {
+	if _, err := tx.q.ExecContext(ctx, `DELETE FROM book_authors WHERE book_id = ?`, book.Id); err != nil {
+		return err
+	}
+	for i, a := range book.Authors {
+		if _, err := tx.q.ExecContext(ctx,
+			`INSERT INTO book_authors (book_id, position, author_id, role) VALUES (?, ?, ?, ?)`,
+			book.Id, i, a.AuthorId, a.Role); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadBookAuthors fills in the author links of books read from the books
+// table
+func (tx *sqlTx) loadBookAuthors(ctx context.Context, books []*pb.Book) error {
+	if len(books) == 0 {
+		return nil
+	}
+	byID, first, last := booksByID(books)
+	query := `SELECT book_id, author_id, role FROM book_authors WHERE book_id BETWEEN ? AND ? ORDER BY book_id, position`
+	rows, err := tx.q.QueryContext(ctx, query, first, last)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var bookID int32
+		link := &pb.BookAuthor{}
+		if err := rows.Scan(&bookID, &link.AuthorId, &link.Role); err != nil {
+			return err
+		}
+		if book, ok := byID[bookID]; ok {
+			book.Authors = append(book.Authors, link)
+		}
+	}
+	return rows.Err()
+}
@@ -0,0 +1,150 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+)
+
+// maxBatchSize is the largest number of items accepted by a batch RPC
+const maxBatchSize = 1000
+
+// batchOp applies one item of a batch within tx. It returns the book to
+// report, and status errors only.
+type batchOp func(tx Tx, i int) (book *pb.Book, err error)
+
+// runBatch applies op to n items inside a single transaction. Without
+// bestEffort the first failing item rolls back the whole batch and its
+// error is returned, prefixed with the item position. With bestEffort each
+// item runs in a savepoint, so a failing item leaves nothing behind, such
+// as an allocated ID, and is reported in its result while the others are
+// committed; internal errors still abort the batch since the transaction
+// may be unusable.
+func (s *LibraryServer) runBatch(ctx context.Context, n int, bestEffort bool, op batchOp) ([]*pb.BatchItemResult, error) {
+	if n > maxBatchSize {
+		return nil, fieldError(codes.InvalidArgument, "requests", fmt.Sprintf("at most %d items are allowed", maxBatchSize))
+	}
+
+	var results []*pb.BatchItemResult
+	err := s.store.RunInTx(ctx, func(tx Tx) error {
+		results = make([]*pb.BatchItemResult, n)
+		for i := 0; i < n; i++ {
+			var book *pb.Book
+			var err error
+			if bestEffort {
+				err = tx.Savepoint(ctx, func() (err error) {
+					book, err = op(tx, i)
+					return err
+				})
+				if _, ok := status.FromError(err); !ok {
+					return err // The savepoint itself failed
+				}
+			} else {
+				book, err = op(tx, i)
+			}
+			if err != nil {
+				st := status.Convert(err)
+				if !bestEffort || st.Code() == codes.Internal {
+					p := st.Proto()
+					p.Message = fmt.Sprintf("requests[%d]: %s", i, p.Message)
+					return status.ErrorProto(p)
+				}
+				results[i] = &pb.BatchItemResult{Code: int32(st.Code()), Message: st.Message()}
+				continue
+			}
+			results[i] = &pb.BatchItemResult{Book: book}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, toStatus(err, bookResourceType, "books")
+	}
+	return results, nil
+}
+
+// BatchCreateBooks implementation
+func (s *LibraryServer) BatchCreateBooks(ctx context.Context, req *pb.BatchCreateBooksRequest) (*pb.BatchCreateBooksResponse, error) {
+	results, err := s.runBatch(ctx, len(req.Requests), req.BestEffort, func(tx Tx, i int) (*pb.Book, error) {
+		book, err := prepareBook(req.Requests[i].GetBook())
+		if err != nil {
+			return nil, err
+		}
+		if err := createBook(ctx, tx, book); err != nil {
+			return nil, bookError(err, book.Id)
+		}
+		return book, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Batch of %d books created", countOK(results))
+
+	return &pb.BatchCreateBooksResponse{Results: results}, nil
+}
+
+// BatchUpdateBooks implementation
+func (s *LibraryServer) BatchUpdateBooks(ctx context.Context, req *pb.BatchUpdateBooksRequest) (*pb.BatchUpdateBooksResponse, error) {
+	results, err := s.runBatch(ctx, len(req.Requests), req.BestEffort, func(tx Tx, i int) (*pb.Book, error) {
+		book, err := prepareBook(req.Requests[i].GetBook())
+		if err != nil {
+			return nil, err
+		}
+		if err := updateBook(ctx, tx, book); err != nil {
+			return nil, bookError(err, book.Id)
+		}
+		return book, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Batch of %d books updated", countOK(results))
+
+	return &pb.BatchUpdateBooksResponse{Results: results}, nil
+}
+
+// BatchDeleteBooks implementation
+func (s *LibraryServer) BatchDeleteBooks(ctx context.Context, req *pb.BatchDeleteBooksRequest) (*pb.BatchDeleteBooksResponse, error) {
+	results, err := s.runBatch(ctx, len(req.Requests), req.BestEffort, func(tx Tx, i int) (*pb.Book, error) {
+		r := req.Requests[i]
+		if err := deleteBook(ctx, tx, r.GetId(), r.GetRevision()); err != nil {
+			return nil, bookError(err, r.GetId())
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Batch of %d books deleted", countOK(results))
+
+	return &pb.BatchDeleteBooksResponse{Results: results}, nil
+}
+
+// BatchGetBooks implementation
+func (s *LibraryServer) BatchGetBooks(ctx context.Context, req *pb.BatchGetBooksRequest) (*pb.BatchGetBooksResponse, error) {
+	results, err := s.runBatch(ctx, len(req.Ids), req.BestEffort, func(tx Tx, i int) (*pb.Book, error) {
+		book, err := tx.GetBook(ctx, req.Ids[i])
+		if err != nil {
+			return nil, bookError(err, req.Ids[i])
+		}
+		return book, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.BatchGetBooksResponse{Results: results}, nil
+}
+
+// countOK returns the number of successful items in a batch
+func countOK(results []*pb.BatchItemResult) int {
+	n := 0
+	for _, r := range results {
+		if r.Code == int32(codes.OK) {
+			n++
+		}
+	}
+	return n
+}
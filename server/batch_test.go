@@ -0,0 +1,92 @@
+package server
+
+import (
+	"context"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+	"testing"
+)
+
+func TestBatchCreateBooks(t *testing.T) {
+	ctx := context.Background()
+	forEachStore(t, func(t *testing.T, store Store) {
+		s, err := NewLibraryServer(store, DefaultWatchHistory)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// The second book takes the ISBN of the first, after an ID was
+		// allocated for it
+		req := &pb.BatchCreateBooksRequest{Requests: []*pb.CreateBookRequest{
+			{Book: &pb.Book{Title: "Dune", Isbn: "9780441013593"}},
+			{Book: &pb.Book{Title: "Dune again", Isbn: "9780441013593"}},
+			{Book: &pb.Book{Title: "Emma"}},
+		}}
+
+		_, err = s.BatchCreateBooks(ctx, req)
+		if status.Code(err) != codes.AlreadyExists || !strings.Contains(err.Error(), "requests[1]") {
+			t.Errorf("atomic batch: got %v, want AlreadyExists on requests[1]", err)
+		}
+		if books, _ := store.ListBooks(ctx); len(books) != 0 {
+			t.Errorf("atomic batch left %d books", len(books))
+		}
+
+		req.BestEffort = true
+		resp, err := s.BatchCreateBooks(ctx, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := codes.Code(resp.Results[1].Code); got != codes.AlreadyExists {
+			t.Errorf("failing item: got %v, want AlreadyExists", got)
+		}
+		// Nothing of the failing item survives, not even its ID
+		for i, want := range map[int]int32{0: 1, 2: 2} {
+			if r := resp.Results[i]; r.Code != 0 || r.Book.GetId() != want {
+				t.Errorf("item %d: code %d, book %v, want ID %d", i, r.Code, r.Book, want)
+			}
+		}
+		books, err := store.ListBooks(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(books) != 2 || books[0].Title != "Dune" || books[1].Title != "Emma" {
+			t.Errorf("best-effort batch stored %v", books)
+		}
+	})
+}
+
+func TestBatchDeleteBooks(t *testing.T) {
+	ctx := context.Background()
+	forEachStore(t, func(t *testing.T, store Store) {
+		s, err := NewLibraryServer(store, DefaultWatchHistory)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, title := range []string{"Dune", "Emma"} {
+			if _, err := s.CreateBook(ctx, &pb.CreateBookRequest{Book: &pb.Book{Title: title}}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		req := &pb.BatchDeleteBooksRequest{Requests: []*pb.DeleteBookRequest{{Id: 1}, {Id: 2, Revision: 5}}}
+
+		if _, err := s.BatchDeleteBooks(ctx, req); status.Code(err) != codes.Aborted {
+			t.Errorf("atomic batch: got %v, want Aborted", err)
+		}
+		if books, _ := store.ListBooks(ctx); len(books) != 2 {
+			t.Errorf("atomic batch left %d books, want 2", len(books))
+		}
+
+		req.BestEffort = true
+		resp, err := s.BatchDeleteBooks(ctx, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.Results[0].Code != 0 || codes.Code(resp.Results[1].Code) != codes.Aborted {
+			t.Errorf("got results %v, want OK and Aborted", resp.Results)
+		}
+		if books, _ := store.ListBooks(ctx); len(books) != 1 || books[0].Id != 2 {
+			t.Errorf("best-effort batch left %v, want book 2", books)
+		}
+	})
+}
@@ -0,0 +1,405 @@
+package server
+
+import (
+	"context"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"math"
+	"slices"
+	"sync"
+)
+
+// BookStore is the in-memory Store implementation
+type BookStore struct {
+	books      map[int32]*pb.Book
+	bookIDs    []int32          // IDs of the stored books in ascending order, for paging
+	isbns      map[string]int32 // Book ID by ISBN, for uniqueness
+	lastBookID int32            // Highest book ID ever stored, never decreases
+	authors    *memTable[*pb.Author]
+	branches   *memTable[*pb.Branch]
+	transfers  *memTable[*pb.BookTransfer]
+	members    *memTable[*pb.Member]
+	copies     *memTable[*pb.Copy]
+	loans      *memTable[*pb.Loan]
+	holds      *memTable[*pb.Hold]
+	ledger     *memTable[*pb.LedgerEntry]
+	tables     []memTableOps // Every memTable, for replay and snapshots
+	mu         sync.Mutex    // Mutex to handle concurrent access
+
+	// commit, when set, is called with the changes of every successful
+	// read-write transaction before the store lock is released. An error
+	// rolls the transaction back.
+	commit func(muts []mutation) error
+
+	observer      func(changes []BookChange) // Set by ObserveBooks
+	lastFeedEpoch int64                      // Last number returned by NextFeedEpoch
+}
+
+// Mutation operations
+const (
+	opPut byte = iota + 1
+	opDelete
+)
+
+// Kinds of records held by the store
+const (
+	kindBook byte = iota + 1
+	kindBookSequence
+	kindMember
+	kindMemberSequence
+	kindCopy
+	kindCopySequence
+	kindLoan
+	kindLoanSequence
+	kindHold
+	kindHoldSequence
+	kindLedgerEntry
+	kindLedgerEntrySequence
+	kindAuthor
+	kindAuthorSequence
+	kindBranch
+	kindBranchSequence
+	kindTransfer
+	kindTransferSequence
+)
+
+// mutation is a single change made by a transaction. For deletions msg
+// only carries the key of the removed record.
+type mutation struct {
+	op   byte
+	kind byte
+	msg  proto.Message
+}
+
+// NewBookStore creates an empty in-memory BookStore
+func NewBookStore() *BookStore {
+	s := &BookStore{
+		books:   make(map[int32]*pb.Book),
+		isbns:   make(map[string]int32),
+		authors: newMemTable[*pb.Author](kindAuthor, kindAuthorSequence),
+		branches: newMemTable[*pb.Branch](kindBranch, kindBranchSequence).
+			withUniqueKey(func(b *pb.Branch) string { return b.Code }),
+		transfers: newMemTable[*pb.BookTransfer](kindTransfer, kindTransferSequence),
+		members:   newMemTable[*pb.Member](kindMember, kindMemberSequence),
+		copies: newMemTable[*pb.Copy](kindCopy, kindCopySequence).
+			withUniqueKey(func(c *pb.Copy) string { return c.Barcode }),
+		loans:  newMemTable[*pb.Loan](kindLoan, kindLoanSequence),
+		holds:  newMemTable[*pb.Hold](kindHold, kindHoldSequence),
+		ledger: newMemTable[*pb.LedgerEntry](kindLedgerEntry, kindLedgerEntrySequence),
+	}
+	s.tables = []memTableOps{s.authors, s.branches, s.transfers, s.members, s.copies, s.loans, s.holds, s.ledger}
+	return s
+}
+
+// memTx applies operations directly to the BookStore maps and keeps an
+// undo log so that a failed transaction can be rolled back
+type memTx struct {
+	s       *BookStore
+	undo    []func()
+	muts    []mutation
+	changes []BookChange // Book changes for the observer
+}
+
+// rollback reverts every change made through the transaction
+func (tx *memTx) rollback() {
+	for i := len(tx.undo) - 1; i >= 0; i-- {
+		tx.undo[i]()
+	}
+	tx.undo = nil
+	tx.muts = nil
+	tx.changes = nil
+}
+
+// Savepoint implementation, rolling back to the current length of the undo
+// log
+func (tx *memTx) Savepoint(ctx context.Context, fn func() error) error {
+	undo, muts, changes := len(tx.undo), len(tx.muts), len(tx.changes)
+	if err := fn(); err != nil {
+		for i := len(tx.undo) - 1; i >= undo; i-- {
+			tx.undo[i]()
+		}
+		tx.undo, tx.muts, tx.changes = tx.undo[:undo], tx.muts[:muts], tx.changes[:changes]
+		return err
+	}
+	return nil
+}
+
+// apply replays a mutation read back from durable storage
+func (s *BookStore) apply(m mutation) {
+	switch m.kind {
+	case kindBook:
+		book := m.msg.(*pb.Book)
+		if m.op == opDelete {
+			s.setBook(book.Id, nil)
+		} else {
+			s.setBook(book.Id, book)
+			s.lastBookID = max(s.lastBookID, book.Id)
+		}
+	case kindBookSequence:
+		s.lastBookID = max(s.lastBookID, m.msg.(*wrapperspb.Int32Value).Value)
+	default:
+		for _, t := range s.tables {
+			if t.apply(m) {
+				return
+			}
+		}
+	}
+}
+
+// snapshot returns the current state as a list of put mutations
+func (s *BookStore) snapshot() []mutation {
+	muts := make([]mutation, 0, len(s.books)+1)
+	muts = append(muts, mutation{op: opPut, kind: kindBookSequence, msg: wrapperspb.Int32(s.lastBookID)})
+	for _, book := range s.books {
+		muts = append(muts, mutation{op: opPut, kind: kindBook, msg: book})
+	}
+	for _, t := range s.tables {
+		muts = append(muts, t.snapshot()...)
+	}
+	return muts
+}
+
+// setBook stores book under id, or removes the entry if book is nil, and
+// keeps the ISBN and ID indexes in sync
+func (s *BookStore) setBook(id int32, book *pb.Book) {
+	prev, exists := s.books[id]
+	if exists && prev.Isbn != "" && s.isbns[prev.Isbn] == id {
+		delete(s.isbns, prev.Isbn)
+	}
+	i, _ := slices.BinarySearch(s.bookIDs, id)
+	if book == nil {
+		if exists {
+			s.bookIDs = slices.Delete(s.bookIDs, i, i+1)
+		}
+		delete(s.books, id)
+		return
+	}
+	if !exists {
+		s.bookIDs = slices.Insert(s.bookIDs, i, id)
+	}
+	s.books[id] = book
+	if book.Isbn != "" {
+		s.isbns[book.Isbn] = id
+	}
+}
+
+// checkISBN fails if another book already uses the ISBN of book
+func (tx *memTx) checkISBN(book *pb.Book) error {
+	if id, ok := tx.s.isbns[book.Isbn]; ok && book.Isbn != "" && id != book.Id {
+		return ErrDuplicateISBN
+	}
+	return nil
+}
+
+// putBook stores book and records how to restore the previous state
+func (tx *memTx) putBook(book *pb.Book) {
+	prev := tx.s.books[book.Id]
+	tx.s.setBook(book.Id, book)
+	tx.muts = append(tx.muts, mutation{op: opPut, kind: kindBook, msg: book})
+	tx.undo = append(tx.undo, func() { tx.s.setBook(book.Id, prev) })
+	tx.changes = append(tx.changes, BookChange{Before: prev, After: book})
+	if book.Id > tx.s.lastBookID {
+		tx.setLastBookID(book.Id)
+	}
+}
+
+// setLastBookID moves the book ID sequence and records how to restore it
+func (tx *memTx) setLastBookID(id int32) {
+	prev := tx.s.lastBookID
+	tx.s.lastBookID = id
+	tx.undo = append(tx.undo, func() { tx.s.lastBookID = prev })
+}
+
+// NextBookID allocates the next unused book ID
+func (tx *memTx) NextBookID(ctx context.Context) (int32, error) {
+	if tx.s.lastBookID == math.MaxInt32 {
+		return 0, ErrIDsExhausted
+	}
+	id := tx.s.lastBookID + 1
+	tx.setLastBookID(id)
+	tx.muts = append(tx.muts, mutation{op: opPut, kind: kindBookSequence, msg: wrapperspb.Int32(id)})
+	return id, nil
+}
+
+// CreateBook adds a new book, failing if its ID or ISBN is already taken
+func (tx *memTx) CreateBook(ctx context.Context, book *pb.Book) error {
+	if _, exists := tx.s.books[book.Id]; exists {
+		return ErrAlreadyExists
+	}
+	if err := tx.checkISBN(book); err != nil {
+		return err
+	}
+	book.Revision = 1
+	tx.putBook(proto.Clone(book).(*pb.Book))
+	return nil
+}
+
+// GetBook returns a copy of the book with the given ID
+func (tx *memTx) GetBook(ctx context.Context, id int32) (*pb.Book, error) {
+	book, exists := tx.s.books[id]
+	if !exists {
+		return nil, ErrNotFound
+	}
+	return proto.Clone(book).(*pb.Book), nil
+}
+
+// FindBookByISBN returns a copy of the book with the given normalized ISBN
+func (tx *memTx) FindBookByISBN(ctx context.Context, isbn string) (*pb.Book, error) {
+	id, exists := tx.s.isbns[isbn]
+	if !exists || isbn == "" {
+		return nil, ErrNotFound
+	}
+	return tx.GetBook(ctx, id)
+}
+
+// UpdateBook replaces an existing book, failing if its new ISBN is taken
+func (tx *memTx) UpdateBook(ctx context.Context, book *pb.Book) error {
+	prev, exists := tx.s.books[book.Id]
+	if !exists {
+		return ErrNotFound
+	}
+	if err := tx.checkISBN(book); err != nil {
+		return err
+	}
+	book.Revision = prev.Revision + 1
+	tx.putBook(proto.Clone(book).(*pb.Book))
+	return nil
+}
+
+// DeleteBook removes the book with the given ID
+func (tx *memTx) DeleteBook(ctx context.Context, id int32) error {
+	prev, exists := tx.s.books[id]
+	if !exists {
+		return ErrNotFound
+	}
+	tx.s.setBook(id, nil)
+	tx.muts = append(tx.muts, mutation{op: opDelete, kind: kindBook, msg: &pb.Book{Id: id}})
+	tx.undo = append(tx.undo, func() { tx.s.setBook(id, prev) })
+	tx.changes = append(tx.changes, BookChange{Before: prev})
+	return nil
+}
+
+// ListBooks returns a copy of every book ordered by ID
+func (tx *memTx) ListBooks(ctx context.Context) ([]*pb.Book, error) {
+	return tx.ListBooksAfter(ctx, 0, len(tx.s.bookIDs))
+}
+
+// ListBooksAfter returns a copy of up to limit books with an ID greater
+// than afterID, ordered by ID
+func (tx *memTx) ListBooksAfter(ctx context.Context, afterID int32, limit int) ([]*pb.Book, error) {
+	i, found := slices.BinarySearch(tx.s.bookIDs, afterID)
+	if found {
+		i++
+	}
+	ids := tx.s.bookIDs[i:]
+	ids = ids[:min(len(ids), limit)]
+	books := make([]*pb.Book, 0, len(ids))
+	for _, id := range ids {
+		books = append(books, proto.Clone(tx.s.books[id]).(*pb.Book))
+	}
+	return books, nil
+}
+
+// RunInTx runs fn while holding the store lock and rolls back on error
+func (s *BookStore) RunInTx(ctx context.Context, fn func(tx Tx) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx := &memTx{s: s}
+	if err := fn(tx); err != nil {
+		tx.rollback()
+		return err
+	}
+	if s.commit != nil && len(tx.muts) > 0 {
+		if err := s.commit(tx.muts); err != nil {
+			tx.rollback()
+			return err
+		}
+	}
+	if s.observer != nil && len(tx.changes) > 0 {
+		s.observer(tx.changes)
+	}
+	return nil
+}
+
+// ObserveBooks implementation
+func (s *BookStore) ObserveBooks(fn func(changes []BookChange)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.observer = fn
+}
+
+// NextFeedEpoch implementation. The in-memory store starts empty on every
+// run, so it only has to remember the numbers returned in this one.
+func (s *BookStore) NextFeedEpoch(ctx context.Context, atLeast int64) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastFeedEpoch = max(atLeast, s.lastFeedEpoch+1)
+	return s.lastFeedEpoch, nil
+}
+
+// CreateBook implementation
+func (s *BookStore) CreateBook(ctx context.Context, book *pb.Book) error {
+	return s.RunInTx(ctx, func(tx Tx) error { return tx.CreateBook(ctx, book) })
+}
+
+// GetBook implementation
+func (s *BookStore) GetBook(ctx context.Context, id int32) (book *pb.Book, err error) {
+	err = s.RunInTx(ctx, func(tx Tx) error {
+		book, err = tx.GetBook(ctx, id)
+		return err
+	})
+	return book, err
+}
+
+// FindBookByISBN implementation
+func (s *BookStore) FindBookByISBN(ctx context.Context, isbn string) (book *pb.Book, err error) {
+	err = s.RunInTx(ctx, func(tx Tx) error {
+		book, err = tx.FindBookByISBN(ctx, isbn)
+		return err
+	})
+	return book, err
+}
+
+// UpdateBook implementation
+func (s *BookStore) UpdateBook(ctx context.Context, book *pb.Book) error {
+	return s.RunInTx(ctx, func(tx Tx) error { return tx.UpdateBook(ctx, book) })
+}
+
+// NextBookID implementation
+func (s *BookStore) NextBookID(ctx context.Context) (id int32, err error) {
+	err = s.RunInTx(ctx, func(tx Tx) error {
+		id, err = tx.NextBookID(ctx)
+		return err
+	})
+	return id, err
+}
+
+// DeleteBook implementation
+func (s *BookStore) DeleteBook(ctx context.Context, id int32) error {
+	return s.RunInTx(ctx, func(tx Tx) error { return tx.DeleteBook(ctx, id) })
+}
+
+// ListBooks implementation
+func (s *BookStore) ListBooks(ctx context.Context) (books []*pb.Book, err error) {
+	err = s.RunInTx(ctx, func(tx Tx) error {
+		books, err = tx.ListBooks(ctx)
+		return err
+	})
+	return books, err
+}
+
+// ListBooksAfter implementation
+func (s *BookStore) ListBooksAfter(ctx context.Context, afterID int32, limit int) (books []*pb.Book, err error) {
+	err = s.RunInTx(ctx, func(tx Tx) error {
+		books, err = tx.ListBooksAfter(ctx, afterID, limit)
+		return err
+	})
+	return books, err
+}
+
+// Close is a no-op for the in-memory store
+func (s *BookStore) Close() error {
+	return nil
+}
@@ -0,0 +1,396 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// BranchServer is used to implement the BranchService
+type BranchServer struct {
+	pb.UnimplementedBranchServiceServer
+	store Store
+	now   func() time.Time
+}
+
+// NewBranchServer creates a BranchServer backed by the given Store
+func NewBranchServer(store Store) *BranchServer {
+	return &BranchServer{store: store, now: time.Now}
+}
+
+// clock returns the current time at the precision transfers are stored with
+func (s *BranchServer) clock() time.Time {
+	return s.now().Truncate(time.Second)
+}
+
+// validBranchCode reports whether code is made of lowercase letters, digits
+// and hyphens
+func validBranchCode(code string) bool {
+	if code == "" {
+		return false
+	}
+	for _, r := range code {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// parseClock returns the minutes since midnight of a "HH:MM" time
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil || len(s) != len("15:04") {
+		return 0, fmt.Errorf("time %q is not in HH:MM format", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// prepareHours validates opening hours and sorts them by day and time
+func prepareHours(hours []*pb.Branch_OpeningHours) error {
+	type period struct{ opens, closes int }
+	periods := make(map[*pb.Branch_OpeningHours]period, len(hours))
+	for i, h := range hours {
+		field := fmt.Sprintf("branch.hours[%d]", i)
+		if h.Day < pb.Branch_MONDAY || h.Day > pb.Branch_SUNDAY {
+			return fieldError(codes.InvalidArgument, field+".day", "day must be MONDAY to SUNDAY")
+		}
+		opens, err := parseClock(h.Opens)
+		if err != nil {
+			return fieldError(codes.InvalidArgument, field+".opens", err.Error())
+		}
+		closes, err := parseClock(h.Closes)
+		if err != nil {
+			return fieldError(codes.InvalidArgument, field+".closes", err.Error())
+		}
+		if closes <= opens {
+			return fieldError(codes.InvalidArgument, field+".closes", "closing time must be after opening time")
+		}
+		periods[h] = period{opens, closes}
+	}
+
+	sort.SliceStable(hours, func(i, j int) bool {
+		if hours[i].Day != hours[j].Day {
+			return hours[i].Day < hours[j].Day
+		}
+		return periods[hours[i]].opens < periods[hours[j]].opens
+	})
+	for i := 1; i < len(hours); i++ {
+		prev, h := hours[i-1], hours[i]
+		if prev.Day == h.Day && periods[h].opens < periods[prev].closes {
+			return fieldError(codes.InvalidArgument, "branch.hours",
+				fmt.Sprintf("opening hours overlap on %s", h.Day))
+		}
+	}
+	return nil
+}
+
+// prepareBranch validates a branch sent by a client and returns a
+// normalized copy
+func prepareBranch(branch *pb.Branch) (*pb.Branch, error) {
+	if branch == nil {
+		return nil, fieldError(codes.InvalidArgument, "branch", "branch is required")
+	}
+	branch = proto.Clone(branch).(*pb.Branch)
+	if branch.Code = strings.TrimSpace(branch.Code); !validBranchCode(branch.Code) {
+		return nil, fieldError(codes.InvalidArgument, "branch.code",
+			"code is required and may only contain lowercase letters, digits and hyphens")
+	}
+	if branch.Name = strings.TrimSpace(branch.Name); branch.Name == "" {
+		return nil, fieldError(codes.InvalidArgument, "branch.name", "name is required")
+	}
+	branch.Address = strings.TrimSpace(branch.Address)
+	if err := prepareHours(branch.Hours); err != nil {
+		return nil, err
+	}
+	return branch, nil
+}
+
+// loadBranch reads a branch within tx and checks its revision unless
+// expected is zero
+func loadBranch(ctx context.Context, tx Tx, id int32, expected int64) (*pb.Branch, error) {
+	branch, err := tx.GetBranch(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if expected != 0 && branch.Revision != expected {
+		return nil, ErrRevisionMismatch
+	}
+	return branch, nil
+}
+
+// checkBranch fails with an error on field unless id names a branch
+func checkBranch(ctx context.Context, tx Tx, field string, id int32) error {
+	if id == 0 {
+		return fieldError(codes.InvalidArgument, field, "branch is required")
+	}
+	_, err := tx.GetBranch(ctx, id)
+	if errors.Is(err, ErrNotFound) {
+		return fieldError(codes.InvalidArgument, field, branchName(id)+" not found")
+	}
+	return err
+}
+
+// checkBookBranches validates the branches a book is scoped to within tx,
+// and sorts them without repeats
+func checkBookBranches(ctx context.Context, tx Tx, book *pb.Book) error {
+	for i, id := range book.BranchIds {
+		_, err := tx.GetBranch(ctx, id)
+		if errors.Is(err, ErrNotFound) {
+			return fieldError(codes.InvalidArgument, fmt.Sprintf("book.branch_ids[%d]", i), branchName(id)+" not found")
+		} else if err != nil {
+			return err
+		}
+	}
+	slices.Sort(book.BranchIds)
+	book.BranchIds = slices.Compact(book.BranchIds)
+	return nil
+}
+
+// atBranch reports whether a book can be found at a branch, that is
+// whether it is scoped to it or shared. Every book is at branch 0.
+func atBranch(book *pb.Book, branchID int32) bool {
+	return branchID == 0 || len(book.BranchIds) == 0 || slices.Contains(book.BranchIds, branchID)
+}
+
+// CreateBranch implementation. The server assigns the next ID when the
+// request leaves it empty.
+func (s *BranchServer) CreateBranch(ctx context.Context, req *pb.CreateBranchRequest) (*pb.CreateBranchResponse, error) {
+	branch, err := prepareBranch(req.Branch)
+	if err != nil {
+		return nil, err
+	}
+	err = s.store.RunInTx(ctx, func(tx Tx) error {
+		if branch.Id == 0 {
+			id, err := tx.NextBranchID(ctx)
+			if err != nil {
+				return err
+			}
+			branch.Id = id
+		}
+		return tx.CreateBranch(ctx, branch)
+	})
+	if err != nil {
+		return nil, branchError(err, branch.Id)
+	}
+	log.Printf("Branch added: %d %s", branch.Id, branch.Code)
+
+	return &pb.CreateBranchResponse{Branch: branch}, nil
+}
+
+// GetBranch implementation
+func (s *BranchServer) GetBranch(ctx context.Context, req *pb.GetBranchRequest) (*pb.GetBranchResponse, error) {
+	var branch *pb.Branch
+	err := s.store.RunInTx(ctx, func(tx Tx) (err error) {
+		branch, err = tx.GetBranch(ctx, req.Id)
+		return err
+	})
+	if err != nil {
+		return nil, branchError(err, req.Id)
+	}
+
+	return &pb.GetBranchResponse{Branch: branch}, nil
+}
+
+// UpdateBranch implementation
+func (s *BranchServer) UpdateBranch(ctx context.Context, req *pb.UpdateBranchRequest) (*pb.UpdateBranchResponse, error) {
+	branch, err := prepareBranch(req.Branch)
+	if err != nil {
+		return nil, err
+	}
+	err = s.store.RunInTx(ctx, func(tx Tx) error {
+		if _, err := loadBranch(ctx, tx, branch.Id, branch.Revision); err != nil {
+			return err
+		}
+		return tx.UpdateBranch(ctx, branch)
+	})
+	if err != nil {
+		return nil, branchError(err, branch.Id)
+	}
+	log.Printf("Branch updated: %d", branch.Id)
+
+	return &pb.UpdateBranchResponse{Branch: branch}, nil
+}
+
+// DeleteBranch implementation
+func (s *BranchServer) DeleteBranch(ctx context.Context, req *pb.DeleteBranchRequest) (*pb.DeleteBranchResponse, error) {
+	err := s.store.RunInTx(ctx, func(tx Tx) error {
+		if _, err := loadBranch(ctx, tx, req.Id, req.Revision); err != nil {
+			return err
+		}
+		books, err := tx.ListBooks(ctx)
+		if err != nil {
+			return err
+		}
+		scoped := 0
+		for _, book := range books {
+			if slices.Contains(book.BranchIds, req.Id) {
+				scoped++
+			}
+		}
+		copies, err := tx.ListCopies(ctx, 0)
+		if err != nil {
+			return err
+		}
+		kept := 0
+		for _, c := range copies {
+			if c.BranchId == req.Id && c.Status != pb.Copy_RETIRED {
+				kept++
+			}
+		}
+		if scoped > 0 || kept > 0 {
+			return preconditionError("BRANCH_IN_USE", branchName(req.Id),
+				fmt.Sprintf("%d books are scoped to this branch and %d copies are kept there", scoped, kept))
+		}
+		return tx.DeleteBranch(ctx, req.Id)
+	})
+	if err != nil {
+		return nil, branchError(err, req.Id)
+	}
+	log.Printf("Branch deleted: %d", req.Id)
+
+	return &pb.DeleteBranchResponse{Success: true}, nil
+}
+
+// ListBranches implementation
+func (s *BranchServer) ListBranches(ctx context.Context, req *pb.ListBranchesRequest) (*pb.ListBranchesResponse, error) {
+	var branches []*pb.Branch
+	err := s.store.RunInTx(ctx, func(tx Tx) (err error) {
+		branches, err = tx.ListBranches(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, toStatus(err, branchResourceType, "branches")
+	}
+
+	return &pb.ListBranchesResponse{Branches: branches}, nil
+}
+
+// TransferBook implementation. The book, its copies and the audit record
+// are changed in one transaction.
+func (s *BranchServer) TransferBook(ctx context.Context, req *pb.TransferBookRequest) (*pb.TransferBookResponse, error) {
+	if req.FromBranchId == req.ToBranchId {
+		return nil, fieldError(codes.InvalidArgument, "to_branch_id", "book must move to a different branch")
+	}
+
+	transfer := &pb.BookTransfer{
+		BookId:        req.BookId,
+		FromBranchId:  req.FromBranchId,
+		ToBranchId:    req.ToBranchId,
+		Note:          strings.TrimSpace(req.Note),
+		TransferredAt: timestamppb.New(s.clock()),
+	}
+	var book *pb.Book
+	rescoped := false
+	err := s.store.RunInTx(ctx, func(tx Tx) (err error) {
+		if book, err = tx.GetBook(ctx, req.BookId); err != nil {
+			return bookError(err, req.BookId)
+		}
+		from, err := tx.GetBranch(ctx, req.FromBranchId)
+		if err != nil {
+			return branchError(err, req.FromBranchId)
+		}
+		to, err := tx.GetBranch(ctx, req.ToBranchId)
+		if err != nil {
+			return branchError(err, req.ToBranchId)
+		}
+
+		if len(book.BranchIds) > 0 {
+			i := slices.Index(book.BranchIds, from.Id)
+			if i < 0 {
+				return preconditionError("NOT_AT_BRANCH", bookName(book.Id),
+					fmt.Sprintf("book is not scoped to %s", branchName(from.Id)))
+			}
+			book.BranchIds[i] = to.Id
+			slices.Sort(book.BranchIds)
+			book.BranchIds = slices.Compact(book.BranchIds)
+			rescoped = true
+		}
+
+		copies, err := tx.ListCopies(ctx, book.Id)
+		if err != nil {
+			return err
+		}
+		for _, c := range copies {
+			if c.BranchId == from.Id && c.Status == pb.Copy_AVAILABLE {
+				c.BranchId = to.Id
+				if err := tx.UpdateCopy(ctx, c); err != nil {
+					return copyError(err, c.Id)
+				}
+				transfer.CopyIds = append(transfer.CopyIds, c.Id)
+			}
+		}
+		if !rescoped && len(transfer.CopyIds) == 0 {
+			return preconditionError("NOTHING_TO_TRANSFER", bookName(book.Id),
+				fmt.Sprintf("book is shared and has no available copies at %s", branchName(from.Id)))
+		}
+
+		if rescoped {
+			if err := tx.UpdateBook(ctx, book); err != nil {
+				return bookError(err, book.Id)
+			}
+		}
+		if transfer.Id, err = tx.NextTransferID(ctx); err != nil {
+			return err
+		}
+		return tx.CreateTransfer(ctx, transfer)
+	})
+	if err != nil {
+		return nil, toStatus(err, branchResourceType, "transfers")
+	}
+	log.Printf("Book transferred: %d from branch %d to %d", book.Id, req.FromBranchId, req.ToBranchId)
+
+	return &pb.TransferBookResponse{Book: book, Transfer: transfer}, nil
+}
+
+// ListTransfers implementation. Transfers are listed newest first and kept
+// after their book or branches are deleted.
+func (s *BranchServer) ListTransfers(ctx context.Context, req *pb.ListTransfersRequest) (*pb.ListTransfersResponse, error) {
+	pageSize, err := resolvePageSize("page_size", req.PageSize, defaultPageSize, maxPageSize)
+	if err != nil {
+		return nil, err
+	}
+	var before int32
+	if req.PageToken != "" {
+		if before, err = decodeIDPageToken(req.PageToken); err != nil {
+			return nil, fieldError(codes.InvalidArgument, "page_token", err.Error())
+		}
+	}
+
+	var transfers []*pb.BookTransfer
+	err = s.store.RunInTx(ctx, func(tx Tx) (err error) {
+		transfers, err = tx.ListTransfers(ctx, TransferFilter{BookID: req.BookId, BranchID: req.BranchId})
+		return err
+	})
+	if err != nil {
+		return nil, toStatus(err, branchResourceType, "transfers")
+	}
+
+	newest := make([]*pb.BookTransfer, 0, len(transfers))
+	for i := len(transfers) - 1; i >= 0; i-- {
+		newest = append(newest, transfers[i])
+	}
+	start := 0
+	if before != 0 {
+		start = sort.Search(len(newest), func(i int) bool { return newest[i].Id < before })
+	}
+	end := min(start+pageSize, len(newest))
+
+	resp := &pb.ListTransfersResponse{
+		Transfers: newest[start:end],
+		TotalSize: int32(len(newest)),
+	}
+	if end < len(newest) {
+		resp.NextPageToken = encodeIDPageToken(newest[end-1].Id)
+	}
+	return resp, nil
+}
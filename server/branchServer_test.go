@@ -0,0 +1,226 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io/fs"
+	"path/filepath"
+	"testing"
+)
+
+// legacyCopies are copies as stored before they referred to branches by ID.
+// The codes missing from the branches are met in the order of their IDs in
+// either store, so that "Main Hall" gets ID 2 and the code "branch-2" is
+// already in use.
+var legacyCopies = []struct {
+	barcode, branch string
+}{
+	{"C1", "central"},
+	{"C2", "Main Hall"},
+	{"C3", "branch-2"},
+	{"C4", "north"},
+	{"C5", "north"},
+}
+
+// checkConvertedCopies checks that legacyCopies were moved to branches,
+// with "central" created beforehand
+func checkConvertedCopies(t *testing.T, store Store) {
+	t.Helper()
+	ctx := context.Background()
+	var branches []*pb.Branch
+	var copies []*pb.Copy
+	err := store.RunInTx(ctx, func(tx Tx) (err error) {
+		if branches, err = tx.ListBranches(ctx); err != nil {
+			return err
+		}
+		copies, err = tx.ListCopies(ctx, 0)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	byName := make(map[string]*pb.Branch)
+	for _, b := range branches {
+		byName[b.Name] = b
+	}
+	if len(branches) != 4 {
+		t.Fatalf("got %d branches, want 4: %v", len(branches), branches)
+	}
+	for _, code := range []string{"north", "branch-2"} {
+		if got := byName[code].GetCode(); got != code {
+			t.Errorf("branch for the valid code %s has code %q", code, got)
+		}
+	}
+	if got := byName["Main Hall"]; got.GetId() != 2 || got.GetCode() != "branch-2-2" {
+		t.Errorf("branch for an invalid code has ID %d and code %q, want 2 and branch-2-2", got.GetId(), got.GetCode())
+	}
+	want := map[string]int32{"central": 1}
+	for _, lc := range legacyCopies[1:] {
+		want[lc.branch] = byName[lc.branch].GetId()
+	}
+
+	if len(copies) != len(legacyCopies) {
+		t.Fatalf("got %d copies, want %d", len(copies), len(legacyCopies))
+	}
+	for i, c := range copies {
+		if c.LegacyBranch != "" || c.BranchId != want[legacyCopies[i].branch] {
+			t.Errorf("copy %s: branch_id %d, legacy_branch %q, want branch_id %d",
+				c.Barcode, c.BranchId, c.LegacyBranch, want[legacyCopies[i].branch])
+		}
+	}
+}
+
+func TestFileStoreConvertsLegacyBranches(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	s, err := NewFileStore(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = s.RunInTx(ctx, func(tx Tx) error {
+		if err := tx.CreateBranch(ctx, &pb.Branch{Id: 1, Code: "central", Name: "Central"}); err != nil {
+			return err
+		}
+		if err := tx.CreateBook(ctx, &pb.Book{Id: 1, Title: "Dune"}); err != nil {
+			return err
+		}
+		for i, lc := range legacyCopies {
+			c := &pb.Copy{Id: int32(i + 1), BookId: 1, Barcode: lc.barcode, LegacyBranch: lc.branch}
+			if err := tx.CreateCopy(ctx, c); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Close()
+
+	// The conversion is logged, so it survives another restart
+	for i := 0; i < 2; i++ {
+		if s, err = NewFileStore(dir, 0); err != nil {
+			t.Fatal(err)
+		}
+		checkConvertedCopies(t, s)
+		s.Close()
+	}
+}
+
+func TestSQLStoreMigratesLegacyBranches(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "library.db")
+
+	// Build the schema as it was before copies referred to branch IDs
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exec := func(query string, args ...any) {
+		t.Helper()
+		if _, err := db.ExecContext(ctx, query, args...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	exec(`CREATE TABLE schema_migrations (version INTEGER PRIMARY KEY, applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP)`)
+	names, err := fs.Glob(migrations, "migrations/*.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, name := range names[:11] {
+		script, err := migrations.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		exec(string(script))
+		exec(`INSERT INTO schema_migrations (version) VALUES (?)`, i+1)
+	}
+	exec(`INSERT INTO branches (id, code, name) VALUES (1, 'central', 'Central')`)
+	exec(`UPDATE sequences SET value = 1 WHERE name = 'branches'`)
+	exec(`INSERT INTO books (id, title, author) VALUES (1, 'Dune', '')`)
+	for i, lc := range legacyCopies {
+		exec(`INSERT INTO copies (id, book_id, barcode, branch) VALUES (?, 1, ?, ?)`, i+1, lc.barcode, lc.branch)
+	}
+	db.Close()
+
+	s, err := NewSQLStore("sqlite", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	checkConvertedCopies(t, s)
+
+	// New branches continue the sequence
+	err = s.RunInTx(ctx, func(tx Tx) error {
+		id, err := tx.NextBranchID(ctx)
+		if id != 5 {
+			t.Errorf("next branch ID %d, want 5", id)
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCopiesFollowBranchIDs(t *testing.T) {
+	ctx := context.Background()
+	store := newLendingStore(t, 0, 0)
+	branches := NewBranchServer(store)
+	copies := NewCopyServer(store)
+
+	var ids []int32
+	for _, code := range []string{"central", "north"} {
+		resp, err := branches.CreateBranch(ctx, &pb.CreateBranchRequest{Branch: &pb.Branch{Code: code, Name: code}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, resp.Branch.Id)
+	}
+
+	add := func(barcode string, branchID int32) codes.Code {
+		_, err := copies.AddCopy(ctx, &pb.AddCopyRequest{Copy: &pb.Copy{BookId: 1, Barcode: barcode, BranchId: branchID}})
+		return status.Code(err)
+	}
+	if got := add("C1", 0); got != codes.InvalidArgument {
+		t.Errorf("copy without a branch: got %v, want InvalidArgument", got)
+	}
+	if got := add("C1", 99); got != codes.InvalidArgument {
+		t.Errorf("copy at an unknown branch: got %v, want InvalidArgument", got)
+	}
+	if got := add("C1", ids[0]); got != codes.OK {
+		t.Fatalf("copy at a branch: got %v", got)
+	}
+
+	// Renaming a branch leaves its copies where they are
+	if _, err := branches.UpdateBranch(ctx, &pb.UpdateBranchRequest{Branch: &pb.Branch{Id: ids[0], Code: "downtown", Name: "Downtown"}}); err != nil {
+		t.Fatal(err)
+	}
+	list, err := copies.ListCopies(ctx, &pb.ListCopiesRequest{BranchId: ids[0]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Copies) != 1 {
+		t.Errorf("copies at the renamed branch: got %d, want 1", len(list.Copies))
+	}
+	if _, err := branches.DeleteBranch(ctx, &pb.DeleteBranchRequest{Id: ids[0]}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("deleting a branch holding a copy: got %v, want FailedPrecondition", err)
+	}
+
+	if _, err := copies.MoveCopy(ctx, &pb.MoveCopyRequest{Id: list.Copies[0].Id, BranchId: 99}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("move to an unknown branch: got %v, want InvalidArgument", err)
+	}
+	moved, err := copies.MoveCopy(ctx, &pb.MoveCopyRequest{Id: list.Copies[0].Id, BranchId: ids[1]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if moved.Copy.BranchId != ids[1] {
+		t.Errorf("moved copy is at branch %d, want %d", moved.Copy.BranchId, ids[1])
+	}
+	if _, err := branches.DeleteBranch(ctx, &pb.DeleteBranchRequest{Id: ids[0]}); err != nil {
+		t.Errorf("deleting an empty branch: %v", err)
+	}
+}
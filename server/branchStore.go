@@ -0,0 +1,410 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"strconv"
+	"strings"
+)
+
+// matches reports whether a transfer is selected by the filter
+func (f TransferFilter) matches(t *pb.BookTransfer) bool {
+	return (f.BookID == 0 || t.BookId == f.BookID) &&
+		(f.BranchID == 0 || t.FromBranchId == f.BranchID || t.ToBranchId == f.BranchID)
+}
+
+// In-memory implementation of BranchTx
+
+// CreateBranch adds a new branch, failing if its ID or code is already taken
+func (tx *memTx) CreateBranch(ctx context.Context, branch *pb.Branch) error {
+	if _, exists := tx.s.branches.rows[branch.Id]; exists {
+		return ErrAlreadyExists
+	}
+	if err := tx.s.branches.checkUnique(branch, ErrDuplicateBranch); err != nil {
+		return err
+	}
+	branch.Revision = 1
+	tx.s.branches.put(tx, branch)
+	return nil
+}
+
+// GetBranch returns a copy of the branch with the given ID
+func (tx *memTx) GetBranch(ctx context.Context, id int32) (*pb.Branch, error) {
+	return tx.s.branches.get(id)
+}
+
+// UpdateBranch replaces an existing branch, failing if its new code is taken
+func (tx *memTx) UpdateBranch(ctx context.Context, branch *pb.Branch) error {
+	prev, exists := tx.s.branches.rows[branch.Id]
+	if !exists {
+		return ErrNotFound
+	}
+	if err := tx.s.branches.checkUnique(branch, ErrDuplicateBranch); err != nil {
+		return err
+	}
+	branch.Revision = prev.Revision + 1
+	tx.s.branches.put(tx, branch)
+	return nil
+}
+
+// DeleteBranch removes the branch with the given ID
+func (tx *memTx) DeleteBranch(ctx context.Context, id int32) error {
+	return tx.s.branches.delete(tx, id)
+}
+
+// ListBranches returns a copy of every branch ordered by ID
+func (tx *memTx) ListBranches(ctx context.Context) ([]*pb.Branch, error) {
+	return tx.s.branches.list(), nil
+}
+
+// NextBranchID allocates the next unused branch ID
+func (tx *memTx) NextBranchID(ctx context.Context) (int32, error) {
+	return tx.s.branches.nextID(tx)
+}
+
+// CreateTransfer adds a new transfer, failing if its ID is already taken
+func (tx *memTx) CreateTransfer(ctx context.Context, t *pb.BookTransfer) error {
+	if _, exists := tx.s.transfers.rows[t.Id]; exists {
+		return ErrAlreadyExists
+	}
+	tx.s.transfers.put(tx, t)
+	return nil
+}
+
+// ListTransfers returns a copy of the matching transfers ordered by ID
+func (tx *memTx) ListTransfers(ctx context.Context, filter TransferFilter) ([]*pb.BookTransfer, error) {
+	var transfers []*pb.BookTransfer
+	for _, t := range tx.s.transfers.list() {
+		if filter.matches(t) {
+			transfers = append(transfers, t)
+		}
+	}
+	return transfers, nil
+}
+
+// NextTransferID allocates the next unused transfer ID
+func (tx *memTx) NextTransferID(ctx context.Context) (int32, error) {
+	return tx.s.transfers.nextID(tx)
+}
+
+// convertLegacyBranches moves the copies stored with a branch code to the
+// ID of that branch, as migration 0012 does for the SQL store. A code no
+// branch has gets a branch of its own named after it, with the code itself
+// if it is valid and otherwise branch-<id>, suffixed until it is unique.
+// Converted copies no longer carry a code, so only the first call changes
+// anything.
+func convertLegacyBranches(ctx context.Context, store Store) (converted int, err error) {
+	err = store.RunInTx(ctx, func(tx Tx) error {
+		copies, err := tx.ListCopies(ctx, 0)
+		if err != nil {
+			return err
+		}
+		branches, err := tx.ListBranches(ctx)
+		if err != nil {
+			return err
+		}
+		byCode := make(map[string]int32, len(branches))
+		taken := make(map[string]bool, len(branches)) // Codes of the existing and future branches
+		for _, b := range branches {
+			byCode[b.Code] = b.Id
+			taken[b.Code] = true
+		}
+		for _, c := range copies {
+			if validBranchCode(c.LegacyBranch) {
+				taken[c.LegacyBranch] = true
+			}
+		}
+		for _, c := range copies {
+			if c.LegacyBranch == "" {
+				continue
+			}
+			id, ok := byCode[c.LegacyBranch]
+			if !ok {
+				if id, err = tx.NextBranchID(ctx); err != nil {
+					return err
+				}
+				code := c.LegacyBranch
+				if !validBranchCode(code) {
+					code = fmt.Sprintf("branch-%d", id)
+					for n := 2; taken[code]; n++ {
+						code = fmt.Sprintf("branch-%d-%d", id, n)
+					}
+					taken[code] = true
+				}
+				if err := tx.CreateBranch(ctx, &pb.Branch{Id: id, Code: code, Name: c.LegacyBranch}); err != nil {
+					return err
+				}
+				byCode[c.LegacyBranch] = id
+			}
+			c.BranchId, c.LegacyBranch = id, ""
+			if err := tx.UpdateCopy(ctx, c); err != nil {
+				return err
+			}
+			converted++
+		}
+		return nil
+	})
+	return converted, err
+}
+
+// SQL implementation of BranchTx
+
+const branchColumns = `id, code, name, address, hours, revision`
+
+// formatHours encodes opening hours for the hours column
+func formatHours(hours []*pb.Branch_OpeningHours) string {
+	lines := make([]string, len(hours))
+	for i, h := range hours {
+		lines[i] = fmt.Sprintf("%d %s %s", h.Day, h.Opens, h.Closes)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// parseHours decodes the hours column
+func parseHours(s string) ([]*pb.Branch_OpeningHours, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var hours []*pb.Branch_OpeningHours
+	for _, line := range strings.Split(s, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("malformed opening hours %q", line)
+		}
+		day, err := strconv.Atoi(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("malformed opening hours %q", line)
+		}
+		hours = append(hours, &pb.Branch_OpeningHours{
+			Day: pb.Branch_Weekday(day), Opens: fields[1], Closes: fields[2],
+		})
+	}
+	return hours, nil
+}
+
+// scanBranch reads a row selected with branchColumns
+func scanBranch(row scanner) (*pb.Branch, error) {
+	branch := &pb.Branch{}
+	var hours string
+	if err := row.Scan(&branch.Id, &branch.Code, &branch.Name, &branch.Address, &hours, &branch.Revision); err != nil {
+		return nil, err
+	}
+	var err error
+	branch.Hours, err = parseHours(hours)
+	return branch, err
+}
+
+// checkBranchCode fails if another branch already uses the code of branch
+func (tx *sqlTx) checkBranchCode(ctx context.Context, branch *pb.Branch) error {
+	var id int32
+	err := tx.q.QueryRowContext(ctx, `SELECT id FROM branches WHERE code = ? AND id <> ?`, branch.Code, branch.Id).Scan(&id)
+	switch {
+	case err == nil:
+		return ErrDuplicateBranch
+	case errors.Is(err, sql.ErrNoRows):
+		return nil
+	}
+	return err
+}
+
+// CreateBranch implementation
+func (tx *sqlTx) CreateBranch(ctx context.Context, branch *pb.Branch) error {
+	if _, err := tx.GetBranch(ctx, branch.Id); err == nil {
+		return ErrAlreadyExists
+	} else if !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	if err := tx.checkBranchCode(ctx, branch); err != nil {
+		return err
+	}
+	if _, err := tx.q.ExecContext(ctx,
+		`INSERT INTO branches (`+branchColumns+`) VALUES (?, ?, ?, ?, ?, 1)`,
+		branch.Id, branch.Code, branch.Name, branch.Address, formatHours(branch.Hours)); err != nil {
+		return err
+	}
+	branch.Revision = 1
+	return tx.bumpSequence(ctx, "branches", branch.Id)
+}
+
+// GetBranch implementation
+func (tx *sqlTx) GetBranch(ctx context.Context, id int32) (*pb.Branch, error) {
+	branch, err := scanBranch(tx.q.QueryRowContext(ctx, `SELECT `+branchColumns+` FROM branches WHERE id = ?`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return branch, err
+}
+
+// UpdateBranch implementation
+func (tx *sqlTx) UpdateBranch(ctx context.Context, branch *pb.Branch) error {
+	if err := tx.checkBranchCode(ctx, branch); err != nil {
+		return err
+	}
+	err := tx.q.QueryRowContext(ctx,
+		`UPDATE branches SET code = ?, name = ?, address = ?, hours = ?, revision = revision + 1
+		WHERE id = ? RETURNING revision`,
+		branch.Code, branch.Name, branch.Address, formatHours(branch.Hours), branch.Id).Scan(&branch.Revision)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// DeleteBranch implementation
+func (tx *sqlTx) DeleteBranch(ctx context.Context, id int32) error {
+	res, err := tx.q.ExecContext(ctx, `DELETE FROM branches WHERE id = ?`, id)
+	if err != nil {
+		return err
+	}
+	return checkAffected(res)
+}
+
+// ListBranches implementation
+func (tx *sqlTx) ListBranches(ctx context.Context) ([]*pb.Branch, error) {
+	rows, err := tx.q.QueryContext(ctx, `SELECT `+branchColumns+` FROM branches ORDER BY id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var branches []*pb.Branch
+	for rows.Next() {
+		branch, err := scanBranch(rows)
+		if err != nil {
+			return nil, err
+		}
+		branches = append(branches, branch)
+	}
+	return branches, rows.Err()
+}
+
+// NextBranchID implementation
+func (tx *sqlTx) NextBranchID(ctx context.Context) (int32, error) {
+	return tx.nextID(ctx, "branches", "branches")
+}
+
+const transferColumns = `id, book_id, from_branch_id, to_branch_id, copy_ids, note, transferred_at`
+
+// scanTransfer reads a row selected with transferColumns
+func scanTransfer(row scanner) (*pb.BookTransfer, error) {
+	t := &pb.BookTransfer{}
+	var copyIDs string
+	var transferredAt sql.NullInt64
+	if err := row.Scan(&t.Id, &t.BookId, &t.FromBranchId, &t.ToBranchId, &copyIDs, &t.Note, &transferredAt); err != nil {
+		return nil, err
+	}
+	t.TransferredAt = timestampOf(transferredAt)
+	for _, s := range strings.Split(copyIDs, ",") {
+		if s == "" {
+			continue
+		}
+		id, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("malformed copy IDs %q", copyIDs)
+		}
+		t.CopyIds = append(t.CopyIds, int32(id))
+	}
+	return t, nil
+}
+
+// CreateTransfer implementation
+func (tx *sqlTx) CreateTransfer(ctx context.Context, t *pb.BookTransfer) error {
+	var exists int
+	err := tx.q.QueryRowContext(ctx, `SELECT 1 FROM transfers WHERE id = ?`, t.Id).Scan(&exists)
+	if err == nil {
+		return ErrAlreadyExists
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	copyIDs := make([]string, len(t.CopyIds))
+	for i, id := range t.CopyIds {
+		copyIDs[i] = strconv.Itoa(int(id))
+	}
+	if _, err := tx.q.ExecContext(ctx,
+		`INSERT INTO transfers (`+transferColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		t.Id, t.BookId, t.FromBranchId, t.ToBranchId, strings.Join(copyIDs, ","), t.Note,
+		unixSeconds(t.TransferredAt)); err != nil {
+		return err
+	}
+	return tx.bumpSequence(ctx, "transfers", t.Id)
+}
+
+// ListTransfers implementation
+func (tx *sqlTx) ListTransfers(ctx context.Context, filter TransferFilter) ([]*pb.BookTransfer, error) {
+	var where []string
+	var args []any
+	if filter.BookID != 0 {
+		where = append(where, "book_id = ?")
+		args = append(args, filter.BookID)
+	}
+	if filter.BranchID != 0 {
+		where = append(where, "(from_branch_id = ? OR to_branch_id = ?)")
+		args = append(args, filter.BranchID, filter.BranchID)
+	}
+	query := `SELECT ` + transferColumns + ` FROM transfers`
+	if len(where) > 0 {
+		query += ` WHERE ` + strings.Join(where, " AND ")
+	}
+
+	rows, err := tx.q.QueryContext(ctx, query+` ORDER BY id`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var transfers []*pb.BookTransfer
+	for rows.Next() {
+		t, err := scanTransfer(rows)
+		if err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, t)
+	}
+	return transfers, rows.Err()
+}
+
+// NextTransferID implementation
+func (tx *sqlTx) NextTransferID(ctx context.Context) (int32, error) {
+	return tx.nextID(ctx, "transfers", "transfers")
+}
+
+// saveBookBranches replaces the stored branches of a book
+func (tx *sqlTx) saveBookBranches(ctx context.Context, book *pb.Book) error {
+	if _, err := tx.q.ExecContext(ctx, `DELETE FROM book_branches WHERE book_id = ?`, book.Id); err != nil {
+		return err
+	}
+	for _, id := range book.BranchIds {
+		if _, err := tx.q.ExecContext(ctx,
+			`INSERT INTO book_branches (book_id, branch_id) VALUES (?, ?)`, book.Id, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadBookBranches fills in the branches of books read from the books table
+func (tx *sqlTx) loadBookBranches(ctx context.Context, books []*pb.Book) error {
+	if len(books) == 0 {
+		return nil
+	}
+	byID, first, last := booksByID(books)
+	query := `SELECT book_id, branch_id FROM book_branches WHERE book_id BETWEEN ? AND ? ORDER BY book_id, branch_id`
+	rows, err := tx.q.QueryContext(ctx, query, first, last)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var bookID, branchID int32
+		if err := rows.Scan(&bookID, &branchID); err != nil {
+			return err
+		}
+		if book, ok := byID[bookID]; ok {
+			book.BranchIds = append(book.BranchIds, branchID)
+		}
+	}
+	return rows.Err()
+}
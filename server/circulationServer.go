@@ -0,0 +1,341 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log"
+	"sort"
+	"time"
+)
+
+// day is the unit of loan periods
+const day = 24 * time.Hour
+
+// TierPolicy holds the lending rules of a membership tier
+type TierPolicy struct {
+	MaxLoans    int           // Open loans allowed at once
+	LoanPeriod  time.Duration // Time from checkout or renewal to the due date
+	MaxRenewals int32         // Renewals allowed per loan
+}
+
+// LendingPolicy holds the lending rules of every tier and how long copies
+// are set aside for holds
+type LendingPolicy struct {
+	Tiers        map[pb.Member_Tier]TierPolicy // Rules by tier; tiers missing here follow STANDARD
+	PickupWindow time.Duration                 // How long a copy stays set aside for a READY hold
+}
+
+// DefaultLendingPolicy is the lending policy used unless configured
+// otherwise
+var DefaultLendingPolicy = LendingPolicy{
+	Tiers: map[pb.Member_Tier]TierPolicy{
+		pb.Member_STANDARD: {MaxLoans: 5, LoanPeriod: 21 * day, MaxRenewals: 2},
+		pb.Member_STUDENT:  {MaxLoans: 8, LoanPeriod: 28 * day, MaxRenewals: 2},
+		pb.Member_PREMIUM:  {MaxLoans: 15, LoanPeriod: 28 * day, MaxRenewals: 3},
+		pb.Member_STAFF:    {MaxLoans: 25, LoanPeriod: 42 * day, MaxRenewals: 5},
+	},
+	PickupWindow: 7 * day,
+}
+
+// forTier returns the lending rules of a tier, STANDARD if unknown
+func (p LendingPolicy) forTier(tier pb.Member_Tier) TierPolicy {
+	if t, ok := p.Tiers[tier]; ok {
+		return t
+	}
+	return p.Tiers[pb.Member_STANDARD]
+}
+
+// CirculationServer is used to implement the CirculationService
+type CirculationServer struct {
+	pb.UnimplementedCirculationServiceServer
+	store   Store
+	fees    FeePolicy
+	lending LendingPolicy
+	now     func() time.Time
+}
+
+// NewCirculationServer creates a CirculationServer backed by the given Store
+// that lends according to lending, and fines late returns and blocks
+// borrowing according to fees
+func NewCirculationServer(store Store, fees FeePolicy, lending LendingPolicy) *CirculationServer {
+	return &CirculationServer{store: store, fees: fees, lending: lending, now: time.Now}
+}
+
+// clock returns the current time at the precision loans are stored with
+func (s *CirculationServer) clock() time.Time {
+	return s.now().Truncate(time.Second)
+}
+
+// checkCanBorrow fails unless the member is active and not expired
+func checkCanBorrow(member *pb.Member, now time.Time) error {
+	if member.Status != pb.Member_ACTIVE {
+		return preconditionError("MEMBER_STATUS", memberName(member.Id), "membership is "+member.Status.String())
+	}
+	if member.ExpiresAt != nil && member.ExpiresAt.AsTime().Before(now) {
+		return preconditionError("MEMBERSHIP_EXPIRED", memberName(member.Id), "membership has expired")
+	}
+	return nil
+}
+
+// openLoan returns the open loan of a book by a member within tx
+func openLoan(ctx context.Context, tx Tx, bookID, memberID int32) (*pb.Loan, error) {
+	loans, err := tx.ListLoans(ctx, LoanFilter{MemberID: memberID, BookID: bookID, OpenOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	if len(loans) == 0 {
+		return nil, resourceError(codes.NotFound, loanResourceType, memberName(memberID)+"/loans",
+			fmt.Sprintf("no open loan of %s", bookName(bookID)))
+	}
+	return loans[0], nil
+}
+
+// pickCopy returns the copy of a book to lend within tx: the requested one,
+// which must be available, or else the available copy with the lowest ID
+func pickCopy(ctx context.Context, tx Tx, bookID, copyID int32) (*pb.Copy, error) {
+	if copyID != 0 {
+		c, err := tx.GetCopy(ctx, copyID)
+		if err != nil {
+			return nil, copyError(err, copyID)
+		}
+		if c.BookId != bookID {
+			return nil, fieldError(codes.InvalidArgument, "copy_id", fmt.Sprintf("copy is not of %s", bookName(bookID)))
+		}
+		if c.Status != pb.Copy_AVAILABLE {
+			return nil, copyStateError(c.Id, "copy is not available")
+		}
+		return c, nil
+	}
+
+	copies, err := tx.ListCopies(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range copies {
+		if c.Status == pb.Copy_AVAILABLE {
+			return c, nil
+		}
+	}
+	return nil, preconditionError("NO_COPY_AVAILABLE", bookName(bookID), "no copy is available")
+}
+
+// CheckoutBook implementation. All checks and the loan itself happen in one
+// transaction, so two desks can never lend the same copy, nor push a member
+// past their limit, at the same time. The hold queue is served in order: a
+// member without a READY hold can only take a copy left over once every
+// member ahead of them in the queue could have one.
+func (s *CirculationServer) CheckoutBook(ctx context.Context, req *pb.CheckoutBookRequest) (*pb.CheckoutBookResponse, error) {
+	now := s.clock()
+	loan := &pb.Loan{BookId: req.BookId, MemberId: req.MemberId}
+	err := s.store.RunInTx(ctx, func(tx Tx) error {
+		member, err := tx.GetMember(ctx, req.MemberId)
+		if err != nil {
+			return memberError(err, req.MemberId)
+		}
+		if _, err := tx.GetBook(ctx, req.BookId); err != nil {
+			return bookError(err, req.BookId)
+		}
+		if err := checkCanBorrow(member, now); err != nil {
+			return err
+		}
+		if err := s.fees.checkFees(ctx, tx, member.Id); err != nil {
+			return err
+		}
+
+		policy := s.lending.forTier(member.Tier)
+		loans, err := tx.ListLoans(ctx, LoanFilter{MemberID: member.Id, OpenOnly: true})
+		if err != nil {
+			return err
+		}
+		for _, l := range loans {
+			if l.BookId == req.BookId {
+				return preconditionError("ALREADY_BORROWED", loanName(l.Id), "member already has this book on loan")
+			}
+		}
+		if len(loans) >= policy.MaxLoans {
+			return preconditionError("LOAN_LIMIT", memberName(member.Id),
+				fmt.Sprintf("member has reached the limit of %d loans", policy.MaxLoans))
+		}
+
+		// A hold of the member on the book is fulfilled by the checkout. The
+		// copy set aside for it is lent unless the desk scanned another one,
+		// in which case it goes back on the shelf.
+		if err := expireHolds(ctx, tx, req.BookId, now); err != nil {
+			return err
+		}
+		copyID := req.CopyId
+		hold, err := activeHold(ctx, tx, req.BookId, member.Id)
+		if err != nil {
+			return err
+		}
+		if hold == nil || hold.Status == pb.Hold_WAITING {
+			if err := checkHoldQueue(ctx, tx, req.BookId, member.Id); err != nil {
+				return err
+			}
+		}
+		if hold != nil {
+			if hold.Status == pb.Hold_READY && copyID == 0 {
+				copyID = hold.CopyId
+			}
+			if err := closeHold(ctx, tx, hold, pb.Hold_FULFILLED, now); err != nil {
+				return err
+			}
+		}
+
+		c, err := pickCopy(ctx, tx, req.BookId, copyID)
+		if err != nil {
+			return err
+		}
+		c.Status = pb.Copy_ON_LOAN
+		if err := tx.UpdateCopy(ctx, c); err != nil {
+			return err
+		}
+
+		if loan.Id, err = tx.NextLoanID(ctx); err != nil {
+			return err
+		}
+		loan.CopyId = c.Id
+		loan.CheckedOutAt = timestamppb.New(now)
+		loan.DueAt = timestamppb.New(now.Add(policy.LoanPeriod))
+		return tx.CreateLoan(ctx, loan)
+	})
+	if err != nil {
+		return nil, toStatus(err, loanResourceType, loanName(loan.Id))
+	}
+	log.Printf("Book %d lent to member %d, due %s", loan.BookId, loan.MemberId, loan.DueAt.AsTime().Format(time.DateOnly))
+
+	return &pb.CheckoutBookResponse{Loan: loan}, nil
+}
+
+// ReturnBook implementation. Late returns are charged their overdue fine.
+func (s *CirculationServer) ReturnBook(ctx context.Context, req *pb.ReturnBookRequest) (*pb.ReturnBookResponse, error) {
+	now := s.clock()
+	var loan *pb.Loan
+	var fine *pb.LedgerEntry
+	err := s.store.RunInTx(ctx, func(tx Tx) (err error) {
+		if loan, err = openLoan(ctx, tx, req.BookId, req.MemberId); err != nil {
+			return err
+		}
+		loan.ReturnedAt = timestamppb.New(now)
+		if err := tx.UpdateLoan(ctx, loan); err != nil {
+			return err
+		}
+		if fine, err = s.fees.chargeOverdue(ctx, tx, loan, now); err != nil {
+			return err
+		}
+
+		c, err := tx.GetCopy(ctx, loan.CopyId)
+		if err != nil {
+			return err
+		}
+		if c.Status == pb.Copy_ON_LOAN {
+			c.Status = pb.Copy_AVAILABLE
+			return tx.UpdateCopy(ctx, c)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, toStatus(err, loanResourceType, "loans")
+	}
+	log.Printf("Book %d returned by member %d", loan.BookId, loan.MemberId)
+
+	return &pb.ReturnBookResponse{Loan: loan, Fine: fine}, nil
+}
+
+// RenewLoan implementation. The new due date is one loan period from now,
+// and never earlier than the current one. Loans of books other members are
+// waiting for cannot be renewed.
+func (s *CirculationServer) RenewLoan(ctx context.Context, req *pb.RenewLoanRequest) (*pb.RenewLoanResponse, error) {
+	now := s.clock()
+	var loan *pb.Loan
+	err := s.store.RunInTx(ctx, func(tx Tx) (err error) {
+		if loan, err = openLoan(ctx, tx, req.BookId, req.MemberId); err != nil {
+			return err
+		}
+		member, err := tx.GetMember(ctx, loan.MemberId)
+		if err != nil {
+			return memberError(err, loan.MemberId)
+		}
+		if err := checkCanBorrow(member, now); err != nil {
+			return err
+		}
+		if err := s.fees.checkFees(ctx, tx, member.Id); err != nil {
+			return err
+		}
+		policy := s.lending.forTier(member.Tier)
+		if loan.Renewals >= policy.MaxRenewals {
+			return preconditionError("RENEWAL_LIMIT", loanName(loan.Id),
+				fmt.Sprintf("loan has reached the limit of %d renewals", policy.MaxRenewals))
+		}
+		waiting, err := waitingHolds(ctx, tx, loan.BookId)
+		if err != nil {
+			return err
+		}
+		if len(waiting) > 0 {
+			return preconditionError("HOLDS_WAITING", bookName(loan.BookId), "other members are waiting for this book")
+		}
+
+		due := now.Add(policy.LoanPeriod)
+		if current := loan.DueAt.AsTime(); current.After(due) {
+			due = current
+		}
+		loan.DueAt = timestamppb.New(due)
+		loan.Renewals++
+		return tx.UpdateLoan(ctx, loan)
+	})
+	if err != nil {
+		return nil, toStatus(err, loanResourceType, "loans")
+	}
+	log.Printf("Loan %d renewed, due %s", loan.Id, loan.DueAt.AsTime().Format(time.DateOnly))
+
+	return &pb.RenewLoanResponse{Loan: loan}, nil
+}
+
+// ListLoans implementation
+func (s *CirculationServer) ListLoans(ctx context.Context, req *pb.ListLoansRequest) (*pb.ListLoansResponse, error) {
+	pageSize, err := resolvePageSize("page_size", req.PageSize, defaultPageSize, maxPageSize)
+	if err != nil {
+		return nil, err
+	}
+	var before int32
+	if req.PageToken != "" {
+		if before, err = decodeIDPageToken(req.PageToken); err != nil {
+			return nil, fieldError(codes.InvalidArgument, "page_token", err.Error())
+		}
+	}
+
+	var loans []*pb.Loan
+	filter := LoanFilter{MemberID: req.MemberId, BookID: req.BookId, OpenOnly: !req.IncludeReturned || req.OverdueOnly}
+	err = s.store.RunInTx(ctx, func(tx Tx) (err error) {
+		loans, err = tx.ListLoans(ctx, filter)
+		return err
+	})
+	if err != nil {
+		return nil, toStatus(err, loanResourceType, "loans")
+	}
+
+	now := s.clock()
+	matched := make([]*pb.Loan, 0, len(loans))
+	for i := len(loans) - 1; i >= 0; i-- {
+		if !req.OverdueOnly || loans[i].DueAt.AsTime().Before(now) {
+			matched = append(matched, loans[i])
+		}
+	}
+	start := 0
+	if before != 0 {
+		start = sort.Search(len(matched), func(i int) bool { return matched[i].Id < before })
+	}
+	end := min(start+pageSize, len(matched))
+
+	resp := &pb.ListLoansResponse{
+		Loans:     matched[start:end],
+		TotalSize: int32(len(matched)),
+	}
+	if end < len(matched) {
+		resp.NextPageToken = encodeIDPageToken(matched[end-1].Id)
+	}
+	return resp, nil
+}
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+// newLendingStore returns a memory store with a book of the given number of
+// copies and members with IDs 1 to members
+func newLendingStore(t *testing.T, copies, members int) *BookStore {
+	t.Helper()
+	store := NewBookStore()
+	ctx := context.Background()
+	err := store.RunInTx(ctx, func(tx Tx) error {
+		if err := tx.CreateBook(ctx, &pb.Book{Id: 1, Title: "Dune"}); err != nil {
+			return err
+		}
+		for i := 1; i <= copies; i++ {
+			c := &pb.Copy{Id: int32(i), BookId: 1, Barcode: fmt.Sprintf("C%d", i), Status: pb.Copy_AVAILABLE}
+			if err := tx.CreateCopy(ctx, c); err != nil {
+				return err
+			}
+		}
+		for i := 1; i <= members; i++ {
+			if err := tx.CreateMember(ctx, &pb.Member{Id: int32(i), Name: fmt.Sprintf("Member %d", i), Status: pb.Member_ACTIVE}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return store
+}
+
+func TestCheckoutRespectsHoldQueue(t *testing.T) {
+	ctx := context.Background()
+	store := newLendingStore(t, 2, 4)
+	circ := NewCirculationServer(store, DefaultFeePolicy, DefaultLendingPolicy)
+	holds := NewHoldServer(store, DefaultFeePolicy, DefaultLendingPolicy)
+
+	checkout := func(member int32) codes.Code {
+		_, err := circ.CheckoutBook(ctx, &pb.CheckoutBookRequest{BookId: 1, MemberId: member})
+		return status.Code(err)
+	}
+
+	// Member 1 borrows a copy, then members 2 and 3 queue for the book
+	if got := checkout(1); got != codes.OK {
+		t.Fatalf("first checkout: %v", got)
+	}
+	for _, member := range []int32{2, 3} {
+		if _, err := holds.PlaceHold(ctx, &pb.PlaceHoldRequest{BookId: 1, MemberId: member}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The copy left on the shelf is for member 2, first in line
+	if got := checkout(4); got != codes.FailedPrecondition {
+		t.Errorf("member outside the queue: got %v, want FailedPrecondition", got)
+	}
+	if got := checkout(3); got != codes.FailedPrecondition {
+		t.Errorf("second in line: got %v, want FailedPrecondition", got)
+	}
+	if got := checkout(2); got != codes.OK {
+		t.Errorf("first in line: got %v, want OK", got)
+	}
+
+	// Once member 1 returns their copy, member 3 is first in line
+	if _, err := circ.ReturnBook(ctx, &pb.ReturnBookRequest{BookId: 1, MemberId: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if got := checkout(4); got != codes.FailedPrecondition {
+		t.Errorf("member outside the queue after a return: got %v, want FailedPrecondition", got)
+	}
+	if got := checkout(3); got != codes.OK {
+		t.Errorf("next in line after a return: got %v, want OK", got)
+	}
+}
+
+func TestCheckoutLeftoverCopies(t *testing.T) {
+	ctx := context.Background()
+	store := newLendingStore(t, 3, 3)
+	circ := NewCirculationServer(store, DefaultFeePolicy, DefaultLendingPolicy)
+	holds := NewHoldServer(store, DefaultFeePolicy, DefaultLendingPolicy)
+
+	if _, err := holds.PlaceHold(ctx, &pb.PlaceHoldRequest{BookId: 1, MemberId: 1}); err != nil {
+		t.Fatal(err)
+	}
+	// One of the three copies is needed for the queue, the others are not
+	for _, member := range []int32{2, 3} {
+		if _, err := circ.CheckoutBook(ctx, &pb.CheckoutBookRequest{BookId: 1, MemberId: member}); err != nil {
+			t.Errorf("member %d: %v", member, err)
+		}
+	}
+	if _, err := circ.CheckoutBook(ctx, &pb.CheckoutBookRequest{BookId: 1, MemberId: 1}); err != nil {
+		t.Errorf("member with the hold: %v", err)
+	}
+}
@@ -0,0 +1,224 @@
+package server
+
+import (
+	"context"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"log"
+	"sort"
+	"strings"
+)
+
+// CopyServer is used to implement the CopyService
+type CopyServer struct {
+	pb.UnimplementedCopyServiceServer
+	store Store
+}
+
+// NewCopyServer creates a CopyServer backed by the given Store
+func NewCopyServer(store Store) *CopyServer {
+	return &CopyServer{store: store}
+}
+
+// availability counts the copies and waiting holds of a book within tx
+func availability(ctx context.Context, tx Tx, bookID int32) (*pb.Availability, error) {
+	copies, err := tx.ListCopies(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+	a := &pb.Availability{}
+	for _, c := range copies {
+		if c.Status == pb.Copy_RETIRED {
+			continue
+		}
+		a.Total++
+		switch c.Status {
+		case pb.Copy_AVAILABLE:
+			a.Available++
+		case pb.Copy_ON_LOAN:
+			a.OnLoan++
+		case pb.Copy_ON_HOLD:
+			a.OnHold++
+		}
+	}
+	waiting, err := waitingHolds(ctx, tx, bookID)
+	if err != nil {
+		return nil, err
+	}
+	a.HoldsWaiting = int32(len(waiting))
+	return a, nil
+}
+
+// copyStateError reports a copy that is not in a state allowing the change
+func copyStateError(id int32, description string) error {
+	return resourceError(codes.FailedPrecondition, copyResourceType, copyName(id), description)
+}
+
+// loadCopy reads a copy within tx and checks its revision unless expected
+// is zero
+func loadCopy(ctx context.Context, tx Tx, id int32, expected int64) (*pb.Copy, error) {
+	c, err := tx.GetCopy(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if expected != 0 && c.Revision != expected {
+		return nil, ErrRevisionMismatch
+	}
+	return c, nil
+}
+
+// AddCopy implementation. The server assigns the ID, and the copy starts
+// out available.
+func (s *CopyServer) AddCopy(ctx context.Context, req *pb.AddCopyRequest) (*pb.AddCopyResponse, error) {
+	if req.Copy == nil {
+		return nil, fieldError(codes.InvalidArgument, "copy", "copy is required")
+	}
+	c := proto.Clone(req.Copy).(*pb.Copy)
+	if c.Barcode = strings.TrimSpace(c.Barcode); c.Barcode == "" {
+		return nil, fieldError(codes.InvalidArgument, "copy.barcode", "barcode is required")
+	}
+	c.Shelf = strings.TrimSpace(c.Shelf)
+	c.LegacyBranch = ""
+	if _, ok := pb.Copy_Condition_name[int32(c.Condition)]; !ok {
+		return nil, fieldError(codes.InvalidArgument, "copy.condition", "unknown condition")
+	}
+	if c.Condition == pb.Copy_CONDITION_UNSPECIFIED {
+		c.Condition = pb.Copy_GOOD
+	}
+	c.Status = pb.Copy_AVAILABLE
+
+	err := s.store.RunInTx(ctx, func(tx Tx) error {
+		if _, err := tx.GetBook(ctx, c.BookId); err != nil {
+			return bookError(err, c.BookId)
+		}
+		if err := checkBranch(ctx, tx, "copy.branch_id", c.BranchId); err != nil {
+			return err
+		}
+		id, err := tx.NextCopyID(ctx)
+		if err != nil {
+			return err
+		}
+		c.Id = id
+		return tx.CreateCopy(ctx, c)
+	})
+	if err != nil {
+		return nil, copyError(err, c.Id)
+	}
+	log.Printf("Copy added: %d of book %d", c.Id, c.BookId)
+
+	return &pb.AddCopyResponse{Copy: c}, nil
+}
+
+// GetCopy implementation
+func (s *CopyServer) GetCopy(ctx context.Context, req *pb.GetCopyRequest) (*pb.GetCopyResponse, error) {
+	var c *pb.Copy
+	err := s.store.RunInTx(ctx, func(tx Tx) (err error) {
+		c, err = tx.GetCopy(ctx, req.Id)
+		return err
+	})
+	if err != nil {
+		return nil, copyError(err, req.Id)
+	}
+
+	return &pb.GetCopyResponse{Copy: c}, nil
+}
+
+// MoveCopy implementation
+func (s *CopyServer) MoveCopy(ctx context.Context, req *pb.MoveCopyRequest) (*pb.MoveCopyResponse, error) {
+	var c *pb.Copy
+	err := s.store.RunInTx(ctx, func(tx Tx) (err error) {
+		if c, err = loadCopy(ctx, tx, req.Id, req.Revision); err != nil {
+			return err
+		}
+		if c.Status == pb.Copy_RETIRED {
+			return copyStateError(c.Id, "copy is retired")
+		}
+		if err := checkBranch(ctx, tx, "branch_id", req.BranchId); err != nil {
+			return err
+		}
+		c.BranchId, c.Shelf = req.BranchId, strings.TrimSpace(req.Shelf)
+		return tx.UpdateCopy(ctx, c)
+	})
+	if err != nil {
+		return nil, copyError(err, req.Id)
+	}
+	log.Printf("Copy moved: %d to branch %d", c.Id, c.BranchId)
+
+	return &pb.MoveCopyResponse{Copy: c}, nil
+}
+
+// RetireCopy implementation
+func (s *CopyServer) RetireCopy(ctx context.Context, req *pb.RetireCopyRequest) (*pb.RetireCopyResponse, error) {
+	if _, ok := pb.Copy_Condition_name[int32(req.Condition)]; !ok {
+		return nil, fieldError(codes.InvalidArgument, "condition", "unknown condition")
+	}
+
+	var c *pb.Copy
+	err := s.store.RunInTx(ctx, func(tx Tx) (err error) {
+		if c, err = loadCopy(ctx, tx, req.Id, req.Revision); err != nil {
+			return err
+		}
+		switch c.Status {
+		case pb.Copy_RETIRED:
+			return copyStateError(c.Id, "copy is already retired")
+		case pb.Copy_ON_LOAN:
+			return copyStateError(c.Id, "copy is on loan")
+		case pb.Copy_ON_HOLD:
+			return copyStateError(c.Id, "copy is set aside for a hold")
+		}
+		c.Status = pb.Copy_RETIRED
+		if req.Condition != pb.Copy_CONDITION_UNSPECIFIED {
+			c.Condition = req.Condition
+		}
+		return tx.UpdateCopy(ctx, c)
+	})
+	if err != nil {
+		return nil, copyError(err, req.Id)
+	}
+	log.Printf("Copy retired: %d", c.Id)
+
+	return &pb.RetireCopyResponse{Copy: c}, nil
+}
+
+// ListCopies implementation
+func (s *CopyServer) ListCopies(ctx context.Context, req *pb.ListCopiesRequest) (*pb.ListCopiesResponse, error) {
+	pageSize, err := resolvePageSize("page_size", req.PageSize, defaultPageSize, maxPageSize)
+	if err != nil {
+		return nil, err
+	}
+	var after int32
+	if req.PageToken != "" {
+		if after, err = decodeIDPageToken(req.PageToken); err != nil {
+			return nil, fieldError(codes.InvalidArgument, "page_token", err.Error())
+		}
+	}
+
+	var copies []*pb.Copy
+	err = s.store.RunInTx(ctx, func(tx Tx) (err error) {
+		copies, err = tx.ListCopies(ctx, req.BookId)
+		return err
+	})
+	if err != nil {
+		return nil, toStatus(err, copyResourceType, "copies")
+	}
+
+	matched := copies[:0]
+	for _, c := range copies {
+		if (req.IncludeRetired || c.Status != pb.Copy_RETIRED) &&
+			(req.BranchId == 0 || c.BranchId == req.BranchId) {
+			matched = append(matched, c)
+		}
+	}
+	start := sort.Search(len(matched), func(i int) bool { return matched[i].Id > after })
+	end := min(start+pageSize, len(matched))
+
+	resp := &pb.ListCopiesResponse{
+		Copies:    matched[start:end],
+		TotalSize: int32(len(matched)),
+	}
+	if end < len(matched) {
+		resp.NextPageToken = encodeIDPageToken(matched[end-1].Id)
+	}
+	return resp, nil
+}
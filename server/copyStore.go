@@ -0,0 +1,157 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+)
+
+// In-memory implementation of CopyTx
+
+// CreateCopy adds a new copy, failing if its ID or barcode is already taken
+func (tx *memTx) CreateCopy(ctx context.Context, c *pb.Copy) error {
+	if _, exists := tx.s.copies.rows[c.Id]; exists {
+		return ErrAlreadyExists
+	}
+	if err := tx.s.copies.checkUnique(c, ErrDuplicateBarcode); err != nil {
+		return err
+	}
+	c.Revision = 1
+	tx.s.copies.put(tx, c)
+	return nil
+}
+
+// GetCopy returns a copy of the copy with the given ID
+func (tx *memTx) GetCopy(ctx context.Context, id int32) (*pb.Copy, error) {
+	return tx.s.copies.get(id)
+}
+
+// UpdateCopy replaces an existing copy, failing if its new barcode is taken
+func (tx *memTx) UpdateCopy(ctx context.Context, c *pb.Copy) error {
+	prev, exists := tx.s.copies.rows[c.Id]
+	if !exists {
+		return ErrNotFound
+	}
+	if err := tx.s.copies.checkUnique(c, ErrDuplicateBarcode); err != nil {
+		return err
+	}
+	c.Revision = prev.Revision + 1
+	tx.s.copies.put(tx, c)
+	return nil
+}
+
+// ListCopies returns the copies of a book, or all copies, ordered by ID
+func (tx *memTx) ListCopies(ctx context.Context, bookID int32) ([]*pb.Copy, error) {
+	copies := tx.s.copies.list()
+	if bookID == 0 {
+		return copies, nil
+	}
+	matched := copies[:0]
+	for _, c := range copies {
+		if c.BookId == bookID {
+			matched = append(matched, c)
+		}
+	}
+	return matched, nil
+}
+
+// NextCopyID allocates the next unused copy ID
+func (tx *memTx) NextCopyID(ctx context.Context) (int32, error) {
+	return tx.s.copies.nextID(tx)
+}
+
+// SQL implementation of CopyTx
+
+const copyColumns = `id, book_id, barcode, branch_id, shelf, condition, status, revision`
+
+// scanCopy reads a row selected with copyColumns
+func scanCopy(row scanner) (*pb.Copy, error) {
+	c := &pb.Copy{}
+	err := row.Scan(&c.Id, &c.BookId, &c.Barcode, &c.BranchId, &c.Shelf, &c.Condition, &c.Status, &c.Revision)
+	return c, err
+}
+
+// checkBarcode fails if another copy already uses the barcode of c
+func (tx *sqlTx) checkBarcode(ctx context.Context, c *pb.Copy) error {
+	var id int32
+	err := tx.q.QueryRowContext(ctx, `SELECT id FROM copies WHERE barcode = ? AND id <> ?`, c.Barcode, c.Id).Scan(&id)
+	switch {
+	case err == nil:
+		return ErrDuplicateBarcode
+	case errors.Is(err, sql.ErrNoRows):
+		return nil
+	}
+	return err
+}
+
+// CreateCopy implementation
+func (tx *sqlTx) CreateCopy(ctx context.Context, c *pb.Copy) error {
+	if _, err := tx.GetCopy(ctx, c.Id); err == nil {
+		return ErrAlreadyExists
+	} else if !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	if err := tx.checkBarcode(ctx, c); err != nil {
+		return err
+	}
+	if _, err := tx.q.ExecContext(ctx,
+		`INSERT INTO copies (`+copyColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, 1)`,
+		c.Id, c.BookId, c.Barcode, c.BranchId, c.Shelf, c.Condition, c.Status); err != nil {
+		return err
+	}
+	c.Revision = 1
+	return tx.bumpSequence(ctx, "copies", c.Id)
+}
+
+// GetCopy implementation
+func (tx *sqlTx) GetCopy(ctx context.Context, id int32) (*pb.Copy, error) {
+	c, err := scanCopy(tx.q.QueryRowContext(ctx, `SELECT `+copyColumns+` FROM copies WHERE id = ?`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return c, err
+}
+
+// UpdateCopy implementation
+func (tx *sqlTx) UpdateCopy(ctx context.Context, c *pb.Copy) error {
+	if err := tx.checkBarcode(ctx, c); err != nil {
+		return err
+	}
+	err := tx.q.QueryRowContext(ctx,
+		`UPDATE copies SET book_id = ?, barcode = ?, branch_id = ?, shelf = ?, condition = ?, status = ?,
+		revision = revision + 1 WHERE id = ? RETURNING revision`,
+		c.BookId, c.Barcode, c.BranchId, c.Shelf, c.Condition, c.Status, c.Id).Scan(&c.Revision)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// ListCopies implementation
+func (tx *sqlTx) ListCopies(ctx context.Context, bookID int32) ([]*pb.Copy, error) {
+	query, args := `SELECT `+copyColumns+` FROM copies ORDER BY id`, []any(nil)
+	if bookID != 0 {
+		query, args = `SELECT `+copyColumns+` FROM copies WHERE book_id = ? ORDER BY id`, []any{bookID}
+	}
+	rows, err := tx.q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var copies []*pb.Copy
+	for rows.Next() {
+		c, err := scanCopy(rows)
+		if err != nil {
+			return nil, err
+		}
+		copies = append(copies, c)
+	}
+	return copies, rows.Err()
+}
+
+// NextCopyID implementation
+func (tx *sqlTx) NextCopyID(ctx context.Context) (int32, error) {
+	return tx.nextID(ctx, "copies", "copies")
+}
@@ -0,0 +1,165 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"log"
+)
+
+// Resource types reported in ResourceInfo error details
+const (
+	bookResourceType   = "library.Book"
+	authorResourceType = "library.Author"
+	branchResourceType = "library.Branch"
+	memberResourceType = "library.Member"
+	copyResourceType   = "library.Copy"
+	loanResourceType   = "library.Loan"
+	holdResourceType   = "library.Hold"
+	ledgerResourceType = "library.LedgerEntry"
+)
+
+// bookName is the resource name of a book in error details
+func bookName(id int32) string {
+	return fmt.Sprintf("books/%d", id)
+}
+
+// authorName is the resource name of an author in error details
+func authorName(id int32) string {
+	return fmt.Sprintf("authors/%d", id)
+}
+
+// branchName is the resource name of a branch in error details
+func branchName(id int32) string {
+	return fmt.Sprintf("branches/%d", id)
+}
+
+// copyName is the resource name of a copy in error details
+func copyName(id int32) string {
+	return fmt.Sprintf("copies/%d", id)
+}
+
+// loanName is the resource name of a loan in error details
+func loanName(id int32) string {
+	return fmt.Sprintf("loans/%d", id)
+}
+
+// holdName is the resource name of a hold in error details
+func holdName(id int32) string {
+	return fmt.Sprintf("holds/%d", id)
+}
+
+// ledgerEntryName is the resource name of a ledger entry in error details
+func ledgerEntryName(id int32) string {
+	return fmt.Sprintf("ledger/%d", id)
+}
+
+// memberName is the resource name of a member in error details
+func memberName(id int32) string {
+	return fmt.Sprintf("members/%d", id)
+}
+
+// withDetails returns a status error carrying the given details
+func withDetails(code codes.Code, msg string, details ...protoadapt.MessageV1) error {
+	st := status.New(code, msg)
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// fieldError returns a status error with a BadRequest detail pointing at the
+// offending request field
+func fieldError(code codes.Code, field, description string) error {
+	return withDetails(code, field+": "+description, &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: field, Description: description},
+		},
+	})
+}
+
+// resourceError returns a status error with a ResourceInfo detail
+func resourceError(code codes.Code, resourceType, name, description string) error {
+	return withDetails(code, name+": "+description, &errdetails.ResourceInfo{
+		ResourceType: resourceType,
+		ResourceName: name,
+		Description:  description,
+	})
+}
+
+// preconditionError returns a FailedPrecondition status error with a
+// PreconditionFailure detail, for requests that break a library rule
+func preconditionError(violation, subject, description string) error {
+	return withDetails(codes.FailedPrecondition, subject+": "+description, &errdetails.PreconditionFailure{
+		Violations: []*errdetails.PreconditionFailure_Violation{
+			{Type: violation, Subject: subject, Description: description},
+		},
+	})
+}
+
+// toStatus maps an error returned by a Store to a gRPC status error about
+// the given resource. Errors that already carry a status are returned as is,
+// and unexpected errors are logged and reported as Internal without leaking
+// their text to clients.
+func toStatus(err error, resourceType, name string) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return resourceError(codes.NotFound, resourceType, name, "not found")
+	case errors.Is(err, ErrAlreadyExists):
+		return resourceError(codes.AlreadyExists, resourceType, name, "already exists")
+	case errors.Is(err, ErrRevisionMismatch):
+		return resourceError(codes.Aborted, resourceType, name, err.Error())
+	case errors.Is(err, ErrDuplicateISBN):
+		return fieldError(codes.AlreadyExists, "book.isbn", err.Error())
+	case errors.Is(err, ErrDuplicateBarcode):
+		return fieldError(codes.AlreadyExists, "copy.barcode", err.Error())
+	case errors.Is(err, ErrDuplicateBranch):
+		return fieldError(codes.AlreadyExists, "branch.code", err.Error())
+	case errors.Is(err, ErrBookHasCopies):
+		return resourceError(codes.FailedPrecondition, resourceType, name, err.Error())
+	case errors.Is(err, ErrIDsExhausted):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	}
+
+	log.Printf("Internal error on %s: %v", name, err)
+	return status.Error(codes.Internal, "internal error")
+}
+
+// bookError maps a Store error about a book to a gRPC status error
+func bookError(err error, id int32) error {
+	return toStatus(err, bookResourceType, bookName(id))
+}
+
+// authorError maps a Store error about an author to a gRPC status error
+func authorError(err error, id int32) error {
+	return toStatus(err, authorResourceType, authorName(id))
+}
+
+// branchError maps a Store error about a branch to a gRPC status error
+func branchError(err error, id int32) error {
+	return toStatus(err, branchResourceType, branchName(id))
+}
+
+// copyError maps a Store error about a copy to a gRPC status error
+func copyError(err error, id int32) error {
+	return toStatus(err, copyResourceType, copyName(id))
+}
+
+// memberError maps a Store error about a member to a gRPC status error
+func memberError(err error, id int32) error {
+	return toStatus(err, memberResourceType, memberName(id))
+}
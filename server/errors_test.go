@@ -0,0 +1,66 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestToStatus(t *testing.T) {
+	tests := []struct {
+		err      error
+		code     codes.Code
+		resource bool   // Carries a ResourceInfo about books/1
+		field    string // Field of a BadRequest detail, if any
+	}{
+		{err: ErrNotFound, code: codes.NotFound, resource: true},
+		{err: fmt.Errorf("get: %w", ErrNotFound), code: codes.NotFound, resource: true},
+		{err: ErrAlreadyExists, code: codes.AlreadyExists, resource: true},
+		{err: ErrRevisionMismatch, code: codes.Aborted, resource: true},
+		{err: ErrBookHasCopies, code: codes.FailedPrecondition, resource: true},
+		{err: ErrDuplicateISBN, code: codes.AlreadyExists, field: "book.isbn"},
+		{err: ErrDuplicateBarcode, code: codes.AlreadyExists, field: "copy.barcode"},
+		{err: ErrDuplicateBranch, code: codes.AlreadyExists, field: "branch.code"},
+		{err: ErrIDsExhausted, code: codes.ResourceExhausted},
+		{err: context.Canceled, code: codes.Canceled},
+		{err: context.DeadlineExceeded, code: codes.DeadlineExceeded},
+		{err: fieldError(codes.InvalidArgument, "book.title", "required"), code: codes.InvalidArgument, field: "book.title"},
+		{err: errors.New("disk on fire"), code: codes.Internal},
+	}
+	for _, tc := range tests {
+		st := status.Convert(bookError(tc.err, 1))
+		if st.Code() != tc.code {
+			t.Errorf("%v: code %v, want %v", tc.err, st.Code(), tc.code)
+			continue
+		}
+		var resource *errdetails.ResourceInfo
+		var field string
+		for _, d := range st.Details() {
+			switch d := d.(type) {
+			case *errdetails.ResourceInfo:
+				resource = d
+			case *errdetails.BadRequest:
+				field = d.FieldViolations[0].Field
+			}
+		}
+		if tc.resource != (resource != nil) {
+			t.Errorf("%v: ResourceInfo %v, want one: %v", tc.err, resource, tc.resource)
+		} else if resource != nil && (resource.ResourceType != bookResourceType || resource.ResourceName != "books/1") {
+			t.Errorf("%v: ResourceInfo about %s %s", tc.err, resource.ResourceType, resource.ResourceName)
+		}
+		if field != tc.field {
+			t.Errorf("%v: field violation %q, want %q", tc.err, field, tc.field)
+		}
+	}
+
+	if bookError(nil, 1) != nil {
+		t.Error("nil error mapped to a status")
+	}
+	if msg := status.Convert(bookError(errors.New("disk on fire"), 1)).Message(); msg != "internal error" {
+		t.Errorf("internal error reported as %q", msg)
+	}
+}
@@ -0,0 +1,497 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log"
+	"sort"
+	"strings"
+	"time"
+)
+
+// FeePolicy holds the amounts used by the fee ledger, in minor currency
+// units
+type FeePolicy struct {
+	OverduePerDay  int64 // Fine per started day late
+	OverdueCap     int64 // Most a single loan can be fined for being late
+	LostItemCap    int64 // Most a lost copy can be charged
+	ManualFeeCap   int64 // Most a single manual fee can be
+	BlockThreshold int64 // Balance from which members cannot borrow, 0 to never block
+}
+
+// DefaultFeePolicy is the fee policy used unless configured otherwise
+var DefaultFeePolicy = FeePolicy{
+	OverduePerDay:  25,
+	OverdueCap:     1000,
+	LostItemCap:    10000,
+	ManualFeeCap:   5000,
+	BlockThreshold: 1000,
+}
+
+// blocks reports whether a balance blocks borrowing
+func (p FeePolicy) blocks(balance int64) bool {
+	return p.BlockThreshold > 0 && balance >= p.BlockThreshold
+}
+
+// checkFees fails if the balance of a member blocks borrowing
+func (p FeePolicy) checkFees(ctx context.Context, tx Tx, memberID int32) error {
+	balance, err := balanceOf(ctx, tx, memberID)
+	if err != nil {
+		return err
+	}
+	if p.blocks(balance) {
+		return preconditionError("FEES_OWED", memberName(memberID),
+			fmt.Sprintf("member owes %d, borrowing is blocked from %d", balance, p.BlockThreshold))
+	}
+	return nil
+}
+
+// overdueFine returns the fine a loan has earned by being late, up to its
+// return or now if it is still open
+func (p FeePolicy) overdueFine(loan *pb.Loan, now time.Time) int64 {
+	end := now
+	if loan.ReturnedAt != nil {
+		end = loan.ReturnedAt.AsTime()
+	}
+	late := end.Sub(loan.DueAt.AsTime())
+	if late <= 0 {
+		return 0
+	}
+	days := int64((late + day - 1) / day)
+	return min(days*p.OverduePerDay, p.OverdueCap)
+}
+
+// signedAmount returns the change an entry makes to the balance
+func signedAmount(entry *pb.LedgerEntry) int64 {
+	switch entry.Type {
+	case pb.LedgerEntry_PAYMENT, pb.LedgerEntry_WAIVER:
+		return -entry.Amount
+	}
+	return entry.Amount
+}
+
+// balanceOf returns the amount a member owes within tx
+func balanceOf(ctx context.Context, tx Tx, memberID int32) (int64, error) {
+	entries, err := tx.ListLedgerEntries(ctx, LedgerFilter{MemberID: memberID})
+	if err != nil {
+		return 0, err
+	}
+	var balance int64
+	for _, entry := range entries {
+		balance += signedAmount(entry)
+	}
+	return balance, nil
+}
+
+// unsettled returns the part of a charge not yet waived, or of a payment not
+// yet refunded
+func unsettled(ctx context.Context, tx Tx, entry *pb.LedgerEntry) (int64, error) {
+	related, err := tx.ListLedgerEntries(ctx, LedgerFilter{RelatedID: entry.Id})
+	if err != nil {
+		return 0, err
+	}
+	left := entry.Amount
+	for _, r := range related {
+		left -= r.Amount
+	}
+	return left, nil
+}
+
+// recordEntry assigns an ID to entry and adds it to the ledger
+func recordEntry(ctx context.Context, tx Tx, entry *pb.LedgerEntry, now time.Time) (err error) {
+	if entry.Id, err = tx.NextLedgerEntryID(ctx); err != nil {
+		return err
+	}
+	entry.CreatedAt = timestamppb.New(now)
+	return tx.CreateLedgerEntry(ctx, entry)
+}
+
+// chargeOverdue charges the part of the overdue fine of a loan not charged
+// yet. It returns nil if there is nothing left to charge.
+func (p FeePolicy) chargeOverdue(ctx context.Context, tx Tx, loan *pb.Loan, now time.Time) (*pb.LedgerEntry, error) {
+	fine := p.overdueFine(loan, now)
+	if fine == 0 {
+		return nil, nil
+	}
+	charged, err := tx.ListLedgerEntries(ctx, LedgerFilter{LoanID: loan.Id})
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range charged {
+		if entry.Type == pb.LedgerEntry_CHARGE && entry.Reason == pb.LedgerEntry_OVERDUE {
+			fine -= entry.Amount
+		}
+	}
+	if fine <= 0 {
+		return nil, nil
+	}
+	entry := &pb.LedgerEntry{
+		MemberId: loan.MemberId,
+		Type:     pb.LedgerEntry_CHARGE,
+		Reason:   pb.LedgerEntry_OVERDUE,
+		Amount:   fine,
+		BookId:   loan.BookId,
+		LoanId:   loan.Id,
+	}
+	return entry, recordEntry(ctx, tx, entry, now)
+}
+
+// checkAmount validates an amount sent by a client. Zero is accepted when
+// optional, and means the most allowed.
+func checkAmount(amount, limit int64, optional bool) error {
+	switch {
+	case amount < 0:
+		return fieldError(codes.InvalidArgument, "amount", "amount must be positive")
+	case amount == 0 && !optional:
+		return fieldError(codes.InvalidArgument, "amount", "amount is required")
+	case limit > 0 && amount > limit:
+		return fieldError(codes.InvalidArgument, "amount", fmt.Sprintf("amount exceeds the cap of %d", limit))
+	}
+	return nil
+}
+
+// FeeServer is used to implement the FeeService
+type FeeServer struct {
+	pb.UnimplementedFeeServiceServer
+	store  Store
+	policy FeePolicy
+	now    func() time.Time
+}
+
+// NewFeeServer creates a FeeServer backed by the given Store
+func NewFeeServer(store Store, policy FeePolicy) *FeeServer {
+	return &FeeServer{store: store, policy: policy, now: time.Now}
+}
+
+// clock returns the current time at the precision entries are stored with
+func (s *FeeServer) clock() time.Time {
+	return s.now().Truncate(time.Second)
+}
+
+// loadEntry reads a ledger entry within tx and checks its type
+func loadEntry(ctx context.Context, tx Tx, id int32, field string, typ pb.LedgerEntry_Type) (*pb.LedgerEntry, error) {
+	entry, err := tx.GetLedgerEntry(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if entry.Type != typ {
+		return nil, fieldError(codes.InvalidArgument, field, fmt.Sprintf("%s is not a %s", ledgerEntryName(id), typ))
+	}
+	return entry, nil
+}
+
+// ChargeOverdue implementation
+func (s *FeeServer) ChargeOverdue(ctx context.Context, req *pb.ChargeOverdueRequest) (*pb.ChargeOverdueResponse, error) {
+	now := s.clock()
+	var entry *pb.LedgerEntry
+	err := s.store.RunInTx(ctx, func(tx Tx) error {
+		loan, err := tx.GetLoan(ctx, req.LoanId)
+		if err != nil {
+			return toStatus(err, loanResourceType, loanName(req.LoanId))
+		}
+		if s.policy.overdueFine(loan, now) == 0 {
+			return preconditionError("NOT_OVERDUE", loanName(loan.Id), "loan is not overdue")
+		}
+		if entry, err = s.policy.chargeOverdue(ctx, tx, loan, now); err != nil {
+			return err
+		}
+		if entry == nil {
+			return preconditionError("ALREADY_CHARGED", loanName(loan.Id), "overdue fine is already charged in full")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, toStatus(err, ledgerResourceType, "ledger")
+	}
+	log.Printf("Overdue fine of %d charged to member %d for loan %d", entry.Amount, entry.MemberId, entry.LoanId)
+
+	return &pb.ChargeOverdueResponse{Entry: entry}, nil
+}
+
+// ChargeLostItem implementation. The loan is closed and the copy retired, as
+// it will not come back.
+func (s *FeeServer) ChargeLostItem(ctx context.Context, req *pb.ChargeLostItemRequest) (*pb.ChargeLostItemResponse, error) {
+	if err := checkAmount(req.Amount, s.policy.LostItemCap, false); err != nil {
+		return nil, err
+	}
+	now := s.clock()
+	var entry *pb.LedgerEntry
+	err := s.store.RunInTx(ctx, func(tx Tx) error {
+		loan, err := tx.GetLoan(ctx, req.LoanId)
+		if err != nil {
+			return toStatus(err, loanResourceType, loanName(req.LoanId))
+		}
+		if loan.ReturnedAt != nil {
+			return preconditionError("LOAN_CLOSED", loanName(loan.Id), "loan is already closed")
+		}
+		loan.ReturnedAt = timestamppb.New(now)
+		if err := tx.UpdateLoan(ctx, loan); err != nil {
+			return err
+		}
+		c, err := tx.GetCopy(ctx, loan.CopyId)
+		if err != nil {
+			return err
+		}
+		c.Status = pb.Copy_RETIRED
+		if err := tx.UpdateCopy(ctx, c); err != nil {
+			return err
+		}
+
+		entry = &pb.LedgerEntry{
+			MemberId: loan.MemberId,
+			Type:     pb.LedgerEntry_CHARGE,
+			Reason:   pb.LedgerEntry_LOST_ITEM,
+			Amount:   req.Amount,
+			BookId:   loan.BookId,
+			LoanId:   loan.Id,
+			Note:     strings.TrimSpace(req.Note),
+		}
+		return recordEntry(ctx, tx, entry, now)
+	})
+	if err != nil {
+		return nil, toStatus(err, ledgerResourceType, "ledger")
+	}
+	log.Printf("Lost copy of book %d charged %d to member %d", entry.BookId, entry.Amount, entry.MemberId)
+
+	return &pb.ChargeLostItemResponse{Entry: entry}, nil
+}
+
+// ChargeFee implementation
+func (s *FeeServer) ChargeFee(ctx context.Context, req *pb.ChargeFeeRequest) (*pb.ChargeFeeResponse, error) {
+	if err := checkAmount(req.Amount, s.policy.ManualFeeCap, false); err != nil {
+		return nil, err
+	}
+	note := strings.TrimSpace(req.Note)
+	if note == "" {
+		return nil, fieldError(codes.InvalidArgument, "note", "note is required")
+	}
+
+	now := s.clock()
+	entry := &pb.LedgerEntry{
+		MemberId: req.MemberId,
+		Type:     pb.LedgerEntry_CHARGE,
+		Reason:   pb.LedgerEntry_MANUAL,
+		Amount:   req.Amount,
+		BookId:   req.BookId,
+		Note:     note,
+	}
+	err := s.store.RunInTx(ctx, func(tx Tx) error {
+		if _, err := tx.GetMember(ctx, req.MemberId); err != nil {
+			return memberError(err, req.MemberId)
+		}
+		if req.BookId != 0 {
+			if _, err := tx.GetBook(ctx, req.BookId); err != nil {
+				return bookError(err, req.BookId)
+			}
+		}
+		return recordEntry(ctx, tx, entry, now)
+	})
+	if err != nil {
+		return nil, toStatus(err, ledgerResourceType, "ledger")
+	}
+	log.Printf("Fee of %d charged to member %d", entry.Amount, entry.MemberId)
+
+	return &pb.ChargeFeeResponse{Entry: entry}, nil
+}
+
+// WaiveCharge implementation. A waiver never takes more than is left of the
+// charge, nor leaves the member in credit.
+func (s *FeeServer) WaiveCharge(ctx context.Context, req *pb.WaiveChargeRequest) (*pb.WaiveChargeResponse, error) {
+	if err := checkAmount(req.Amount, 0, true); err != nil {
+		return nil, err
+	}
+	now := s.clock()
+	var entry *pb.LedgerEntry
+	err := s.store.RunInTx(ctx, func(tx Tx) error {
+		charge, err := loadEntry(ctx, tx, req.ChargeId, "charge_id", pb.LedgerEntry_CHARGE)
+		if err != nil {
+			return err
+		}
+		left, err := unsettled(ctx, tx, charge)
+		if err != nil {
+			return err
+		}
+		balance, err := balanceOf(ctx, tx, charge.MemberId)
+		if err != nil {
+			return err
+		}
+		limit := min(left, balance)
+		if limit <= 0 {
+			return preconditionError("NOTHING_TO_WAIVE", ledgerEntryName(charge.Id), "nothing is left to waive")
+		}
+		amount := req.Amount
+		if amount == 0 {
+			amount = limit
+		}
+		if amount > limit {
+			return preconditionError("WAIVER_TOO_LARGE", ledgerEntryName(charge.Id),
+				fmt.Sprintf("at most %d can be waived", limit))
+		}
+
+		entry = &pb.LedgerEntry{
+			MemberId:  charge.MemberId,
+			Type:      pb.LedgerEntry_WAIVER,
+			Amount:    amount,
+			BookId:    charge.BookId,
+			LoanId:    charge.LoanId,
+			RelatedId: charge.Id,
+			Note:      strings.TrimSpace(req.Note),
+		}
+		return recordEntry(ctx, tx, entry, now)
+	})
+	if err != nil {
+		return nil, toStatus(err, ledgerResourceType, ledgerEntryName(req.ChargeId))
+	}
+	log.Printf("Waived %d of charge %d", entry.Amount, entry.RelatedId)
+
+	return &pb.WaiveChargeResponse{Entry: entry}, nil
+}
+
+// RecordPayment implementation. Payments cannot exceed the balance.
+func (s *FeeServer) RecordPayment(ctx context.Context, req *pb.RecordPaymentRequest) (*pb.RecordPaymentResponse, error) {
+	if err := checkAmount(req.Amount, 0, false); err != nil {
+		return nil, err
+	}
+	now := s.clock()
+	entry := &pb.LedgerEntry{
+		MemberId: req.MemberId,
+		Type:     pb.LedgerEntry_PAYMENT,
+		Amount:   req.Amount,
+		Note:     strings.TrimSpace(req.Note),
+	}
+	err := s.store.RunInTx(ctx, func(tx Tx) error {
+		if _, err := tx.GetMember(ctx, req.MemberId); err != nil {
+			return memberError(err, req.MemberId)
+		}
+		balance, err := balanceOf(ctx, tx, req.MemberId)
+		if err != nil {
+			return err
+		}
+		if req.Amount > balance {
+			return preconditionError("OVERPAYMENT", memberName(req.MemberId),
+				fmt.Sprintf("payment exceeds the balance of %d", balance))
+		}
+		return recordEntry(ctx, tx, entry, now)
+	})
+	if err != nil {
+		return nil, toStatus(err, ledgerResourceType, "ledger")
+	}
+	log.Printf("Payment of %d received from member %d", entry.Amount, entry.MemberId)
+
+	return &pb.RecordPaymentResponse{Entry: entry}, nil
+}
+
+// RefundPayment implementation
+func (s *FeeServer) RefundPayment(ctx context.Context, req *pb.RefundPaymentRequest) (*pb.RefundPaymentResponse, error) {
+	if err := checkAmount(req.Amount, 0, true); err != nil {
+		return nil, err
+	}
+	now := s.clock()
+	var entry *pb.LedgerEntry
+	err := s.store.RunInTx(ctx, func(tx Tx) error {
+		payment, err := loadEntry(ctx, tx, req.PaymentId, "payment_id", pb.LedgerEntry_PAYMENT)
+		if err != nil {
+			return err
+		}
+		left, err := unsettled(ctx, tx, payment)
+		if err != nil {
+			return err
+		}
+		if left <= 0 {
+			return preconditionError("ALREADY_REFUNDED", ledgerEntryName(payment.Id), "payment is already refunded in full")
+		}
+		amount := req.Amount
+		if amount == 0 {
+			amount = left
+		}
+		if amount > left {
+			return preconditionError("REFUND_TOO_LARGE", ledgerEntryName(payment.Id),
+				fmt.Sprintf("at most %d can be refunded", left))
+		}
+
+		entry = &pb.LedgerEntry{
+			MemberId:  payment.MemberId,
+			Type:      pb.LedgerEntry_REFUND,
+			Amount:    amount,
+			RelatedId: payment.Id,
+			Note:      strings.TrimSpace(req.Note),
+		}
+		return recordEntry(ctx, tx, entry, now)
+	})
+	if err != nil {
+		return nil, toStatus(err, ledgerResourceType, ledgerEntryName(req.PaymentId))
+	}
+	log.Printf("Refunded %d of payment %d", entry.Amount, entry.RelatedId)
+
+	return &pb.RefundPaymentResponse{Entry: entry}, nil
+}
+
+// GetBalance implementation
+func (s *FeeServer) GetBalance(ctx context.Context, req *pb.GetBalanceRequest) (*pb.GetBalanceResponse, error) {
+	var balance int64
+	err := s.store.RunInTx(ctx, func(tx Tx) (err error) {
+		if _, err := tx.GetMember(ctx, req.MemberId); err != nil {
+			return memberError(err, req.MemberId)
+		}
+		balance, err = balanceOf(ctx, tx, req.MemberId)
+		return err
+	})
+	if err != nil {
+		return nil, toStatus(err, ledgerResourceType, "ledger")
+	}
+
+	return &pb.GetBalanceResponse{
+		MemberId:       req.MemberId,
+		Balance:        balance,
+		BlockThreshold: s.policy.BlockThreshold,
+		Blocked:        s.policy.blocks(balance),
+	}, nil
+}
+
+// ListLedgerEntries implementation
+func (s *FeeServer) ListLedgerEntries(ctx context.Context, req *pb.ListLedgerEntriesRequest) (*pb.ListLedgerEntriesResponse, error) {
+	pageSize, err := resolvePageSize("page_size", req.PageSize, defaultPageSize, maxPageSize)
+	if err != nil {
+		return nil, err
+	}
+	var before int32
+	if req.PageToken != "" {
+		if before, err = decodeIDPageToken(req.PageToken); err != nil {
+			return nil, fieldError(codes.InvalidArgument, "page_token", err.Error())
+		}
+	}
+
+	var entries []*pb.LedgerEntry
+	err = s.store.RunInTx(ctx, func(tx Tx) (err error) {
+		if _, err := tx.GetMember(ctx, req.MemberId); err != nil {
+			return memberError(err, req.MemberId)
+		}
+		entries, err = tx.ListLedgerEntries(ctx, LedgerFilter{MemberID: req.MemberId})
+		return err
+	})
+	if err != nil {
+		return nil, toStatus(err, ledgerResourceType, "ledger")
+	}
+
+	newest := make([]*pb.LedgerEntry, 0, len(entries))
+	for i := len(entries) - 1; i >= 0; i-- {
+		newest = append(newest, entries[i])
+	}
+	start := 0
+	if before != 0 {
+		start = sort.Search(len(newest), func(i int) bool { return newest[i].Id < before })
+	}
+	end := min(start+pageSize, len(newest))
+
+	resp := &pb.ListLedgerEntriesResponse{
+		Entries:   newest[start:end],
+		TotalSize: int32(len(newest)),
+	}
+	if end < len(newest) {
+		resp.NextPageToken = encodeIDPageToken(newest[end-1].Id)
+	}
+	return resp, nil
+}
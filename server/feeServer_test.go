@@ -0,0 +1,169 @@
+package server
+
+import (
+	"context"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"testing"
+	"time"
+)
+
+func TestOverdueFine(t *testing.T) {
+	due := time.Date(2026, 3, 1, 17, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		returned time.Duration // Relative to the due date, 0 if still on loan
+		now      time.Duration // Relative to the due date
+		want     int64
+	}{
+		{name: "not due yet", now: -time.Hour, want: 0},
+		{name: "due now", now: 0, want: 0},
+		{name: "a second late", now: time.Second, want: 25},
+		{name: "a day late", now: day, want: 25},
+		{name: "a day and a second late", now: day + time.Second, want: 50},
+		{name: "at the cap", now: 40 * day, want: 1000},
+		{name: "past the cap", now: 400 * day, want: 1000},
+		{name: "returned on time", returned: -time.Minute, now: 10 * day, want: 0},
+		{name: "returned late", returned: 3 * day, now: 10 * day, want: 75},
+	}
+	for _, tc := range tests {
+		loan := &pb.Loan{DueAt: timestamppb.New(due)}
+		if tc.returned != 0 {
+			loan.ReturnedAt = timestamppb.New(due.Add(tc.returned))
+		}
+		if got := DefaultFeePolicy.overdueFine(loan, due.Add(tc.now)); got != tc.want {
+			t.Errorf("%s: got %d, want %d", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestFeeLedger(t *testing.T) {
+	ctx := context.Background()
+	store := newLendingStore(t, 1, 1)
+	fees := NewFeeServer(store, DefaultFeePolicy)
+
+	balance := func(want int64, blocked bool) {
+		t.Helper()
+		resp, err := fees.GetBalance(ctx, &pb.GetBalanceRequest{MemberId: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.Balance != want || resp.Blocked != blocked {
+			t.Errorf("balance %d, blocked %v; want %d, %v", resp.Balance, resp.Blocked, want, blocked)
+		}
+	}
+	code := func(err error, want codes.Code) {
+		t.Helper()
+		if status.Code(err) != want {
+			t.Errorf("got %v, want %v", err, want)
+		}
+	}
+	charge := func(amount int64) int32 {
+		t.Helper()
+		resp, err := fees.ChargeFee(ctx, &pb.ChargeFeeRequest{MemberId: 1, Amount: amount, Note: "damage"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.Entry.Id
+	}
+
+	_, err := fees.ChargeFee(ctx, &pb.ChargeFeeRequest{MemberId: 1, Amount: DefaultFeePolicy.ManualFeeCap + 1, Note: "damage"})
+	code(err, codes.InvalidArgument)
+	first, second := charge(600), charge(500)
+	balance(1100, true)
+
+	// Waivers take at most what is left of the charge
+	_, err = fees.WaiveCharge(ctx, &pb.WaiveChargeRequest{ChargeId: first, Amount: 200})
+	code(err, codes.OK)
+	balance(900, false)
+	_, err = fees.WaiveCharge(ctx, &pb.WaiveChargeRequest{ChargeId: first, Amount: 401})
+	code(err, codes.FailedPrecondition)
+	waived, err := fees.WaiveCharge(ctx, &pb.WaiveChargeRequest{ChargeId: first})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if waived.Entry.Amount != 400 {
+		t.Errorf("waiving the rest took %d, want 400", waived.Entry.Amount)
+	}
+	balance(500, false)
+
+	// Payments cannot put the member in credit, nor can waivers after them
+	_, err = fees.RecordPayment(ctx, &pb.RecordPaymentRequest{MemberId: 1, Amount: 501})
+	code(err, codes.FailedPrecondition)
+	paid, err := fees.RecordPayment(ctx, &pb.RecordPaymentRequest{MemberId: 1, Amount: 500})
+	if err != nil {
+		t.Fatal(err)
+	}
+	balance(0, false)
+	_, err = fees.WaiveCharge(ctx, &pb.WaiveChargeRequest{ChargeId: second})
+	code(err, codes.FailedPrecondition)
+	_, err = fees.WaiveCharge(ctx, &pb.WaiveChargeRequest{ChargeId: paid.Entry.Id})
+	code(err, codes.InvalidArgument)
+
+	// Refunds give back at most what was paid
+	_, err = fees.RefundPayment(ctx, &pb.RefundPaymentRequest{PaymentId: paid.Entry.Id, Amount: 501})
+	code(err, codes.FailedPrecondition)
+	_, err = fees.RefundPayment(ctx, &pb.RefundPaymentRequest{PaymentId: paid.Entry.Id, Amount: 200})
+	code(err, codes.OK)
+	balance(200, false)
+	_, err = fees.RefundPayment(ctx, &pb.RefundPaymentRequest{PaymentId: paid.Entry.Id})
+	code(err, codes.OK)
+	balance(500, false)
+	_, err = fees.RefundPayment(ctx, &pb.RefundPaymentRequest{PaymentId: paid.Entry.Id})
+	code(err, codes.FailedPrecondition)
+}
+
+func TestChargeOverdue(t *testing.T) {
+	ctx := context.Background()
+	store := newLendingStore(t, 1, 1)
+	circ := NewCirculationServer(store, DefaultFeePolicy, DefaultLendingPolicy)
+	fees := NewFeeServer(store, DefaultFeePolicy)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	circ.now = func() time.Time { return now }
+	fees.now = func() time.Time { return now }
+
+	resp, err := circ.CheckoutBook(ctx, &pb.CheckoutBookRequest{BookId: 1, MemberId: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	loanID := resp.Loan.Id
+	if _, err := fees.ChargeOverdue(ctx, &pb.ChargeOverdueRequest{LoanId: loanID}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("loan not overdue: got %v, want FailedPrecondition", err)
+	}
+
+	// Each charge only adds what the fine grew by since the last one
+	now = resp.Loan.DueAt.AsTime().Add(2 * day)
+	for i, want := range []int64{50, 0, 25} {
+		entry, err := fees.ChargeOverdue(ctx, &pb.ChargeOverdueRequest{LoanId: loanID})
+		switch {
+		case want == 0 && status.Code(err) != codes.FailedPrecondition:
+			t.Errorf("charge %d: got %v, want FailedPrecondition", i, err)
+		case want != 0 && err != nil:
+			t.Errorf("charge %d: %v", i, err)
+		case want != 0 && entry.Entry.Amount != want:
+			t.Errorf("charge %d: got %d, want %d", i, entry.Entry.Amount, want)
+		}
+		if want == 0 {
+			now = now.Add(day)
+		}
+	}
+
+	// The fine on return is what is left of it
+	now = now.Add(day)
+	ret, err := circ.ReturnBook(ctx, &pb.ReturnBookRequest{BookId: 1, MemberId: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ret.Fine.GetAmount() != 25 {
+		t.Errorf("fine on return: got %d, want 25", ret.Fine.GetAmount())
+	}
+	balance, err := fees.GetBalance(ctx, &pb.GetBalanceRequest{MemberId: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance.Balance != 100 {
+		t.Errorf("balance %d, want 100", balance.Balance)
+	}
+}
@@ -0,0 +1,58 @@
+package server
+
+import (
+	"fmt"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// immutableBookFields cannot be changed through a field mask
+var immutableBookFields = map[string]bool{
+	"id":       true,
+	"revision": true,
+}
+
+// applyBookMask copies the fields of src listed in mask into dst. An empty
+// mask selects every non-empty field of src and "*" selects every field.
+func applyBookMask(dst, src *pb.Book, mask *fieldmaskpb.FieldMask) error {
+	fields := src.ProtoReflect().Descriptor().Fields()
+	var selected []protoreflect.FieldDescriptor
+
+	switch paths := mask.GetPaths(); {
+	case len(paths) == 0:
+		src.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+			if !immutableBookFields[string(fd.Name())] {
+				selected = append(selected, fd)
+			}
+			return true
+		})
+	case len(paths) == 1 && paths[0] == "*":
+		for i := 0; i < fields.Len(); i++ {
+			if fd := fields.Get(i); !immutableBookFields[string(fd.Name())] {
+				selected = append(selected, fd)
+			}
+		}
+	default:
+		for _, path := range paths {
+			fd := fields.ByName(protoreflect.Name(path))
+			if fd == nil {
+				return fmt.Errorf("unknown field %q", path)
+			}
+			if immutableBookFields[path] {
+				return fmt.Errorf("field %q cannot be changed", path)
+			}
+			selected = append(selected, fd)
+		}
+	}
+
+	d, s := dst.ProtoReflect(), src.ProtoReflect()
+	for _, fd := range selected {
+		if s.Has(fd) {
+			d.Set(fd, s.Get(fd))
+		} else {
+			d.Clear(fd)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package server
+
+import (
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"testing"
+)
+
+func TestApplyBookMask(t *testing.T) {
+	stored := &pb.Book{Id: 1, Title: "Dune", Author: "Herbert", Isbn: "9780441013593", PublicationYear: 1965, Genre: "SF", Revision: 3}
+	patch := &pb.Book{Id: 9, Title: "Dune Messiah", PublicationYear: 1969, Revision: 8}
+	tests := []struct {
+		name    string
+		paths   []string // nil for no mask
+		want    *pb.Book
+		wantErr bool
+	}{
+		{name: "no mask", paths: nil,
+			want: &pb.Book{Id: 1, Title: "Dune Messiah", Author: "Herbert", Isbn: "9780441013593", PublicationYear: 1969, Genre: "SF", Revision: 3}},
+		{name: "empty mask", paths: []string{},
+			want: &pb.Book{Id: 1, Title: "Dune Messiah", Author: "Herbert", Isbn: "9780441013593", PublicationYear: 1969, Genre: "SF", Revision: 3}},
+		{name: "every field", paths: []string{"*"},
+			want: &pb.Book{Id: 1, Title: "Dune Messiah", PublicationYear: 1969, Revision: 3}},
+		{name: "listed fields", paths: []string{"title", "genre"},
+			want: &pb.Book{Id: 1, Title: "Dune Messiah", Author: "Herbert", Isbn: "9780441013593", PublicationYear: 1965, Revision: 3}},
+		{name: "unknown field", paths: []string{"subtitle"}, wantErr: true},
+		{name: "nested path", paths: []string{"authors.author_id"}, wantErr: true},
+		{name: "star among fields", paths: []string{"*", "title"}, wantErr: true},
+		{name: "id", paths: []string{"title", "id"}, wantErr: true},
+		{name: "revision", paths: []string{"revision"}, wantErr: true},
+	}
+	for _, tc := range tests {
+		dst := proto.Clone(stored).(*pb.Book)
+		var mask *fieldmaskpb.FieldMask
+		if tc.paths != nil {
+			mask = &fieldmaskpb.FieldMask{Paths: tc.paths}
+		}
+		err := applyBookMask(dst, patch, mask)
+		switch {
+		case tc.wantErr:
+			if err == nil {
+				t.Errorf("%s: applied, want an error", tc.name)
+			}
+		case err != nil:
+			t.Errorf("%s: %v", tc.name, err)
+		case !proto.Equal(dst, tc.want):
+			t.Errorf("%s: got %v, want %v", tc.name, dst, tc.want)
+		}
+	}
+}
@@ -0,0 +1,302 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// File names used inside the FileStore directory
+const (
+	walFileName       = "books.wal"
+	snapshotFileName  = "books.snapshot"
+	feedEpochFileName = "feed.epoch"
+)
+
+// snapshotChunkSize is the number of records per snapshot frame
+const snapshotChunkSize = 1000
+
+// FileStore is a durable Store that keeps the catalog in memory and appends
+// every committed transaction to an fsync'd write-ahead log. On startup the
+// latest snapshot is loaded and the log replayed on top of it; the log is
+// periodically compacted into a new snapshot.
+type FileStore struct {
+	*BookStore
+	dir     string
+	wal     *os.File
+	walSize int64
+
+	// failed is set when a failed append could not be undone, after which
+	// the log may end in a partial record and every append fails
+	failed error
+
+	done      chan struct{}
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+}
+
+// NewFileStore opens or creates a FileStore in dir. A compactInterval of
+// zero disables periodic compaction.
+func NewFileStore(dir string, compactInterval time.Duration) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	s := &FileStore{
+		BookStore: NewBookStore(),
+		dir:       dir,
+		done:      make(chan struct{}),
+	}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	s.BookStore.commit = s.append
+	if n, err := convertLegacyBranches(context.Background(), s); err != nil {
+		s.Close()
+		return nil, fmt.Errorf("convert copy branches: %w", err)
+	} else if n > 0 {
+		log.Printf("Converted the branch codes of %d copies to branch IDs", n)
+	}
+
+	if compactInterval > 0 {
+		s.wg.Add(1)
+		go s.compactLoop(compactInterval)
+	}
+	return s, nil
+}
+
+// load restores the snapshot, replays the write-ahead log and opens it for
+// appending, dropping a torn record left at its end by a crash. The
+// snapshot is written in full before it replaces the previous one, so any
+// damage to it, or to the log before its last record, fails the load
+// rather than silently losing data.
+func (s *FileStore) load() error {
+	if f, err := os.Open(filepath.Join(s.dir, snapshotFileName)); err == nil {
+		_, err = readRecords(f, s.replay)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("read snapshot: %w", err)
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	wal, err := os.OpenFile(filepath.Join(s.dir, walFileName), os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return err
+	}
+	size, err := readRecords(wal, s.replay)
+	if errors.Is(err, errTornRecord) {
+		log.Printf("Dropping a torn record at offset %d of the write-ahead log", size)
+	} else if err != nil {
+		wal.Close()
+		return fmt.Errorf("replay write-ahead log: %w", err)
+	}
+	if err := wal.Truncate(size); err != nil {
+		wal.Close()
+		return err
+	}
+	if _, err := wal.Seek(size, 0); err != nil {
+		wal.Close()
+		return err
+	}
+
+	s.wal = wal
+	s.walSize = size
+	log.Printf("Loaded %d books from %s", len(s.books), s.dir)
+	return nil
+}
+
+// replay applies the mutations of one record to the in-memory state
+func (s *FileStore) replay(muts []mutation) {
+	for _, m := range muts {
+		s.apply(m)
+	}
+}
+
+// append writes the mutations of a transaction to the log and syncs it.
+// It is called with the BookStore lock held.
+func (s *FileStore) append(muts []mutation) error {
+	if s.failed != nil {
+		return s.failed
+	}
+	rec, err := encodeRecord(muts)
+	if err != nil {
+		return err
+	}
+	_, err = s.wal.Write(rec)
+	if err == nil {
+		err = s.wal.Sync()
+	}
+	if err != nil {
+		// Drop the partial write so the next record starts on a boundary
+		if terr := s.wal.Truncate(s.walSize); terr != nil {
+			s.failed = fmt.Errorf("write-ahead log left damaged by a failed append: %w", terr)
+		} else if _, serr := s.wal.Seek(s.walSize, 0); serr != nil {
+			s.failed = fmt.Errorf("write-ahead log left damaged by a failed append: %w", serr)
+		}
+		if s.failed != nil {
+			log.Printf("Refusing further writes to %s: %v", s.dir, s.failed)
+		}
+		return err
+	}
+	s.walSize += int64(len(rec))
+	return nil
+}
+
+// Compact writes the current state to a new snapshot and empties the log
+func (s *FileStore) Compact() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.walSize == 0 {
+		return nil
+	}
+
+	tmp := filepath.Join(s.dir, snapshotFileName+".tmp")
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	err = writeSnapshot(f, s.snapshot())
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if err := os.Rename(tmp, filepath.Join(s.dir, snapshotFileName)); err != nil {
+		return err
+	}
+	if err := syncDir(s.dir); err != nil {
+		return err
+	}
+
+	// Replaying the old log over the new snapshot is harmless, so a crash
+	// before the truncation below does not lose or resurrect anything
+	if err := s.wal.Truncate(0); err != nil {
+		return err
+	}
+	if _, err := s.wal.Seek(0, 0); err != nil {
+		return err
+	}
+	if err := s.wal.Sync(); err != nil {
+		return err
+	}
+	s.walSize = 0
+	s.failed = nil // The log no longer holds the partial record
+	return nil
+}
+
+// NextFeedEpoch implementation, keeping the last number returned in a file
+// of its own so that it survives restarts and compactions
+func (s *FileStore) NextFeedEpoch(ctx context.Context, atLeast int64) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := filepath.Join(s.dir, feedEpochFileName)
+	epoch := atLeast
+	data, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		last, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("read %s: %w", path, err)
+		}
+		epoch = max(epoch, last+1)
+	case !os.IsNotExist(err):
+		return 0, err
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(strconv.FormatInt(epoch, 10)+"\n"), 0o644); err != nil {
+		return 0, err
+	}
+	f, err := os.Open(tmp)
+	if err != nil {
+		return 0, err
+	}
+	err = f.Sync()
+	f.Close()
+	if err == nil {
+		err = os.Rename(tmp, path)
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return 0, err
+	}
+	if err := syncDir(s.dir); err != nil {
+		return 0, err
+	}
+	return epoch, nil
+}
+
+// compactLoop compacts the log every interval until the store is closed
+func (s *FileStore) compactLoop(interval time.Duration) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.Compact(); err != nil {
+				log.Printf("Failed to compact %s: %v", s.dir, err)
+			}
+		case <-s.done:
+			return
+		}
+	}
+}
+
+// Close stops background compaction and closes the log
+func (s *FileStore) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		close(s.done)
+		s.wg.Wait()
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		err = s.wal.Close()
+	})
+	return err
+}
+
+// writeSnapshot writes muts as a series of records of bounded size
+func writeSnapshot(w io.Writer, muts []mutation) error {
+	for len(muts) > 0 {
+		n := min(len(muts), snapshotChunkSize)
+		rec, err := encodeRecord(muts[:n])
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(rec); err != nil {
+			return err
+		}
+		muts = muts[n:]
+	}
+	return nil
+}
+
+// syncDir makes a rename inside dir durable
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
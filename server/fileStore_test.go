@@ -0,0 +1,237 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// fillFileStore creates a FileStore in a new directory holding n books
+func fillFileStore(t *testing.T, n int) (*FileStore, string) {
+	t.Helper()
+	dir := t.TempDir()
+	s, err := NewFileStore(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	for i := 1; i <= n; i++ {
+		book := &pb.Book{Id: int32(i), Title: fmt.Sprintf("Book %d", i)}
+		if err := s.CreateBook(ctx, book); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return s, dir
+}
+
+// countBooks reopens the store in dir and returns its number of books
+func countBooks(t *testing.T, dir string) (int, error) {
+	t.Helper()
+	s, err := NewFileStore(dir, 0)
+	if err != nil {
+		return 0, err
+	}
+	defer s.Close()
+	books, err := s.ListBooks(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return len(books), nil
+}
+
+// flipByte inverts the byte at offset in the file at path
+func flipByte(t *testing.T, path string, offset int64) {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data[offset] ^= 0xff
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// fileSize returns the size of the file at path
+func fileSize(t *testing.T, path string) int64 {
+	t.Helper()
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return info.Size()
+}
+
+func TestFileStoreReopen(t *testing.T) {
+	s, dir := fillFileStore(t, 2500)
+	if err := s.Compact(); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.CreateBook(context.Background(), &pb.Book{Id: 2501}); err != nil {
+		t.Fatal(err)
+	}
+	s.Close()
+
+	n, err := countBooks(t, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2501 {
+		t.Errorf("got %d books after reopening, want 2501", n)
+	}
+}
+
+func TestFileStoreCorruptSnapshot(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		damage func(t *testing.T, path string)
+	}{
+		{"flipped byte", func(t *testing.T, path string) {
+			flipByte(t, path, fileSize(t, path)/2)
+		}},
+		{"flipped last byte", func(t *testing.T, path string) {
+			flipByte(t, path, fileSize(t, path)-1)
+		}},
+		{"truncated", func(t *testing.T, path string) {
+			if err := os.Truncate(path, fileSize(t, path)-3); err != nil {
+				t.Fatal(err)
+			}
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s, dir := fillFileStore(t, 2500)
+			if err := s.Compact(); err != nil {
+				t.Fatal(err)
+			}
+			s.Close()
+
+			tc.damage(t, filepath.Join(dir, snapshotFileName))
+			if n, err := countBooks(t, dir); err == nil {
+				t.Fatalf("opened a damaged snapshot with %d books", n)
+			}
+		})
+	}
+}
+
+func TestFileStoreWALTail(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		damage func(t *testing.T, path string)
+		want   int // Books found after reopening, -1 if opening must fail
+	}{
+		{"intact", func(t *testing.T, path string) {}, 10},
+		{"torn header", func(t *testing.T, path string) {
+			f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			f.Write([]byte{1, 2, 3})
+			f.Close()
+		}, 10},
+		{"torn payload", func(t *testing.T, path string) {
+			if err := os.Truncate(path, fileSize(t, path)-1); err != nil {
+				t.Fatal(err)
+			}
+		}, 9},
+		{"damaged last record", func(t *testing.T, path string) {
+			flipByte(t, path, fileSize(t, path)-1)
+		}, 9},
+		{"damaged first record", func(t *testing.T, path string) {
+			flipByte(t, path, recordHeaderSize+1)
+		}, -1},
+		{"damaged length", func(t *testing.T, path string) {
+			flipByte(t, path, 3)
+		}, -1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s, dir := fillFileStore(t, 10)
+			s.Close()
+
+			path := filepath.Join(dir, walFileName)
+			tc.damage(t, path)
+			n, err := countBooks(t, dir)
+			switch {
+			case tc.want < 0:
+				if !errors.Is(err, errCorruptRecord) {
+					t.Errorf("got %d books and error %v, want %v", n, err, errCorruptRecord)
+				}
+			case err != nil:
+				t.Fatal(err)
+			case n != tc.want:
+				t.Errorf("got %d books, want %d", n, tc.want)
+			}
+		})
+	}
+}
+
+func TestFileStoreUndecodableRecord(t *testing.T) {
+	s, dir := fillFileStore(t, 3)
+	s.Close()
+
+	// A complete record of a kind this version does not know, as written
+	// by a newer one, must not be dropped as if it were torn
+	rec, err := encodeRecord([]mutation{{op: opPut, kind: 255, msg: &pb.Book{Id: 4}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, walFileName)
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Write(rec)
+	f.Close()
+	size := fileSize(t, path)
+
+	if n, err := countBooks(t, dir); !errors.Is(err, errCorruptRecord) {
+		t.Errorf("got %d books and error %v, want %v", n, err, errCorruptRecord)
+	}
+	if got := fileSize(t, path); got != size {
+		t.Errorf("log truncated from %d to %d bytes", size, got)
+	}
+}
+
+func TestFileStoreFailedAppend(t *testing.T) {
+	ctx := context.Background()
+	s, dir := fillFileStore(t, 1)
+	defer s.Close()
+
+	// A log that can be neither written nor truncated leaves the store
+	// refusing writes, even once the log works again, until compaction
+	wal := s.wal
+	ro, err := os.Open(filepath.Join(dir, walFileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ro.Close()
+	s.wal = ro
+	if err := s.CreateBook(ctx, &pb.Book{Id: 2}); err == nil {
+		t.Fatal("append to a read-only log succeeded")
+	}
+	s.wal = wal
+	if err := s.CreateBook(ctx, &pb.Book{Id: 2}); err == nil {
+		t.Error("append after a failed truncation succeeded")
+	}
+	if _, err := s.GetBook(ctx, 2); !errors.Is(err, ErrNotFound) {
+		t.Errorf("book of a failed append: got %v, want %v", err, ErrNotFound)
+	}
+
+	if err := s.Compact(); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.CreateBook(ctx, &pb.Book{Id: 2}); err != nil {
+		t.Errorf("append after compaction: %v", err)
+	}
+}
+
+func TestReadRecordsEmpty(t *testing.T) {
+	n, err := readRecords(bytes.NewReader(nil), func([]mutation) { t.Error("unexpected record") })
+	if n != 0 || err != nil {
+		t.Errorf("got (%d, %v), want (0, nil)", n, err)
+	}
+}
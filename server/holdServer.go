@@ -0,0 +1,345 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log"
+	"sort"
+	"time"
+)
+
+// HoldServer is used to implement the HoldService
+type HoldServer struct {
+	pb.UnimplementedHoldServiceServer
+	store   Store
+	fees    FeePolicy
+	lending LendingPolicy
+	now     func() time.Time
+}
+
+// NewHoldServer creates a HoldServer backed by the given Store that blocks
+// holds according to fees and sets copies aside for the pickup window of
+// lending
+func NewHoldServer(store Store, fees FeePolicy, lending LendingPolicy) *HoldServer {
+	return &HoldServer{store: store, fees: fees, lending: lending, now: time.Now}
+}
+
+// clock returns the current time at the precision holds are stored with
+func (s *HoldServer) clock() time.Time {
+	return s.now().Truncate(time.Second)
+}
+
+// releaseCopy puts a copy that was set aside for a hold back on the shelf
+func releaseCopy(ctx context.Context, tx Tx, copyID int32) error {
+	c, err := tx.GetCopy(ctx, copyID)
+	if err != nil {
+		return err
+	}
+	if c.Status != pb.Copy_ON_HOLD {
+		return nil
+	}
+	c.Status = pb.Copy_AVAILABLE
+	return tx.UpdateCopy(ctx, c)
+}
+
+// closeHold ends a hold with the given status and releases its copy
+func closeHold(ctx context.Context, tx Tx, hold *pb.Hold, status pb.Hold_Status, now time.Time) error {
+	if hold.Status == pb.Hold_READY {
+		if err := releaseCopy(ctx, tx, hold.CopyId); err != nil {
+			return err
+		}
+	}
+	hold.Status = status
+	hold.ClosedAt = timestamppb.New(now)
+	return tx.UpdateHold(ctx, hold)
+}
+
+// holdExpired reports whether hold is READY past its pickup deadline
+func holdExpired(hold *pb.Hold, now time.Time) bool {
+	return hold.Status == pb.Hold_READY && !now.Before(hold.ExpiresAt.AsTime())
+}
+
+// expireHolds closes the READY holds on a book whose pickup deadline has
+// passed, as of their deadline. Expiry is applied lazily, by every
+// transaction that changes the holds or copies of a book, so no background
+// job is needed; reads show expired holds as closed without writing.
+func expireHolds(ctx context.Context, tx Tx, bookID int32, now time.Time) error {
+	holds, err := tx.ListHolds(ctx, HoldFilter{BookID: bookID, ActiveOnly: true})
+	if err != nil {
+		return err
+	}
+	for _, hold := range holds {
+		if holdExpired(hold, now) {
+			if err := closeHold(ctx, tx, hold, pb.Hold_EXPIRED, hold.ExpiresAt.AsTime()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// activeHold returns the WAITING or READY hold of a member on a book, or
+// nil if there is none
+func activeHold(ctx context.Context, tx Tx, bookID, memberID int32) (*pb.Hold, error) {
+	holds, err := tx.ListHolds(ctx, HoldFilter{BookID: bookID, MemberID: memberID, ActiveOnly: true})
+	if err != nil || len(holds) == 0 {
+		return nil, err
+	}
+	return holds[0], nil
+}
+
+// waitingHolds returns the queue of a book, first in line first
+func waitingHolds(ctx context.Context, tx Tx, bookID int32) ([]*pb.Hold, error) {
+	holds, err := tx.ListHolds(ctx, HoldFilter{BookID: bookID, ActiveOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	waiting := holds[:0]
+	for _, hold := range holds {
+		if hold.Status == pb.Hold_WAITING {
+			waiting = append(waiting, hold)
+		}
+	}
+	return waiting, nil
+}
+
+// checkHoldQueue fails if lending a copy of a book to a member would take
+// it from members ahead of them in the queue: beyond the copies set aside
+// for READY holds, a copy on the shelf must remain for every WAITING hold
+// placed before the member's, or for every WAITING hold if the member has
+// none
+func checkHoldQueue(ctx context.Context, tx Tx, bookID, memberID int32) error {
+	waiting, err := waitingHolds(ctx, tx, bookID)
+	if err != nil {
+		return err
+	}
+	ahead := 0
+	for _, hold := range waiting {
+		if hold.MemberId == memberID {
+			break
+		}
+		ahead++
+	}
+	if ahead == 0 {
+		return nil
+	}
+	copies, err := tx.ListCopies(ctx, bookID)
+	if err != nil {
+		return err
+	}
+	available := 0
+	for _, c := range copies {
+		if c.Status == pb.Copy_AVAILABLE {
+			available++
+		}
+	}
+	if available > ahead {
+		return nil
+	}
+	return preconditionError("HOLDS_WAITING", bookName(bookID),
+		fmt.Sprintf("%d members ahead in the hold queue are waiting for this book", ahead))
+}
+
+// setPositions fills in the queue position of the WAITING holds
+func setPositions(ctx context.Context, tx Tx, holds []*pb.Hold) error {
+	queues := make(map[int32]map[int32]int32) // Position by hold ID, per book
+	for _, hold := range holds {
+		if hold.Status != pb.Hold_WAITING {
+			continue
+		}
+		queue, ok := queues[hold.BookId]
+		if !ok {
+			waiting, err := waitingHolds(ctx, tx, hold.BookId)
+			if err != nil {
+				return err
+			}
+			queue = make(map[int32]int32, len(waiting))
+			for i, h := range waiting {
+				queue[h.Id] = int32(i + 1)
+			}
+			queues[hold.BookId] = queue
+		}
+		hold.Position = queue[hold.Id]
+	}
+	return nil
+}
+
+// PlaceHold implementation. A member can hold a book once at a time, and
+// not while borrowing it.
+func (s *HoldServer) PlaceHold(ctx context.Context, req *pb.PlaceHoldRequest) (*pb.PlaceHoldResponse, error) {
+	now := s.clock()
+	hold := &pb.Hold{BookId: req.BookId, MemberId: req.MemberId, Status: pb.Hold_WAITING, PlacedAt: timestamppb.New(now)}
+	err := s.store.RunInTx(ctx, func(tx Tx) error {
+		member, err := tx.GetMember(ctx, req.MemberId)
+		if err != nil {
+			return memberError(err, req.MemberId)
+		}
+		if _, err := tx.GetBook(ctx, req.BookId); err != nil {
+			return bookError(err, req.BookId)
+		}
+		if err := checkCanBorrow(member, now); err != nil {
+			return err
+		}
+		if err := s.fees.checkFees(ctx, tx, member.Id); err != nil {
+			return err
+		}
+		if err := expireHolds(ctx, tx, req.BookId, now); err != nil {
+			return err
+		}
+
+		existing, err := activeHold(ctx, tx, req.BookId, req.MemberId)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return preconditionError("ALREADY_ON_HOLD", holdName(existing.Id), "member already has a hold on this book")
+		}
+		loans, err := tx.ListLoans(ctx, LoanFilter{MemberID: req.MemberId, BookID: req.BookId, OpenOnly: true})
+		if err != nil {
+			return err
+		}
+		if len(loans) > 0 {
+			return preconditionError("ALREADY_BORROWED", loanName(loans[0].Id), "member already has this book on loan")
+		}
+
+		if hold.Id, err = tx.NextHoldID(ctx); err != nil {
+			return err
+		}
+		if err := tx.CreateHold(ctx, hold); err != nil {
+			return err
+		}
+		return setPositions(ctx, tx, []*pb.Hold{hold})
+	})
+	if err != nil {
+		return nil, toStatus(err, holdResourceType, holdName(hold.Id))
+	}
+	log.Printf("Hold placed on book %d by member %d at position %d", hold.BookId, hold.MemberId, hold.Position)
+
+	return &pb.PlaceHoldResponse{Hold: hold}, nil
+}
+
+// CancelHold implementation
+func (s *HoldServer) CancelHold(ctx context.Context, req *pb.CancelHoldRequest) (*pb.CancelHoldResponse, error) {
+	now := s.clock()
+	var hold *pb.Hold
+	err := s.store.RunInTx(ctx, func(tx Tx) (err error) {
+		if err := expireHolds(ctx, tx, req.BookId, now); err != nil {
+			return err
+		}
+		if hold, err = activeHold(ctx, tx, req.BookId, req.MemberId); err != nil {
+			return err
+		}
+		if hold == nil {
+			return resourceError(codes.NotFound, holdResourceType, memberName(req.MemberId)+"/holds",
+				fmt.Sprintf("no active hold on %s", bookName(req.BookId)))
+		}
+		return closeHold(ctx, tx, hold, pb.Hold_CANCELLED, now)
+	})
+	if err != nil {
+		return nil, toStatus(err, holdResourceType, "holds")
+	}
+	log.Printf("Hold %d cancelled", hold.Id)
+
+	return &pb.CancelHoldResponse{Hold: hold}, nil
+}
+
+// ListHolds implementation. Holds past their pickup deadline are listed as
+// EXPIRED, as the next change to the holds of their book will store them.
+func (s *HoldServer) ListHolds(ctx context.Context, req *pb.ListHoldsRequest) (*pb.ListHoldsResponse, error) {
+	pageSize, err := resolvePageSize("page_size", req.PageSize, defaultPageSize, maxPageSize)
+	if err != nil {
+		return nil, err
+	}
+	var after int32
+	if req.PageToken != "" {
+		if after, err = decodeIDPageToken(req.PageToken); err != nil {
+			return nil, fieldError(codes.InvalidArgument, "page_token", err.Error())
+		}
+	}
+
+	now := s.clock()
+	filter := HoldFilter{BookID: req.BookId, MemberID: req.MemberId}
+	var holds []*pb.Hold
+	var start, end int
+	err = s.store.RunInTx(ctx, func(tx Tx) error {
+		filter.ActiveOnly = !req.IncludeClosed
+		stored, err := tx.ListHolds(ctx, filter)
+		if err != nil {
+			return err
+		}
+		holds = stored[:0]
+		for _, hold := range stored {
+			if holdExpired(hold, now) {
+				hold.Status = pb.Hold_EXPIRED
+				hold.ClosedAt = hold.ExpiresAt
+				if !req.IncludeClosed {
+					continue
+				}
+			}
+			holds = append(holds, hold)
+		}
+		start = sort.Search(len(holds), func(i int) bool { return holds[i].Id > after })
+		end = min(start+pageSize, len(holds))
+		return setPositions(ctx, tx, holds[start:end])
+	})
+	if err != nil {
+		return nil, toStatus(err, holdResourceType, "holds")
+	}
+
+	resp := &pb.ListHoldsResponse{
+		Holds:     holds[start:end],
+		TotalSize: int32(len(holds)),
+	}
+	if end < len(holds) {
+		resp.NextPageToken = encodeIDPageToken(holds[end-1].Id)
+	}
+	return resp, nil
+}
+
+// FulfillNextHold implementation. The copy is set aside for the first
+// WAITING hold of the book, which becomes READY until the end of the pickup
+// window. Expired holds are skipped and their copies released first.
+func (s *HoldServer) FulfillNextHold(ctx context.Context, req *pb.FulfillNextHoldRequest) (*pb.FulfillNextHoldResponse, error) {
+	now := s.clock()
+	var hold *pb.Hold
+	err := s.store.RunInTx(ctx, func(tx Tx) error {
+		if _, err := tx.GetBook(ctx, req.BookId); err != nil {
+			return bookError(err, req.BookId)
+		}
+		if err := expireHolds(ctx, tx, req.BookId, now); err != nil {
+			return err
+		}
+		waiting, err := waitingHolds(ctx, tx, req.BookId)
+		if err != nil {
+			return err
+		}
+		if len(waiting) == 0 {
+			return preconditionError("NO_HOLD_WAITING", bookName(req.BookId), "no hold is waiting for this book")
+		}
+		hold = waiting[0]
+
+		c, err := pickCopy(ctx, tx, req.BookId, req.CopyId)
+		if err != nil {
+			return err
+		}
+		c.Status = pb.Copy_ON_HOLD
+		if err := tx.UpdateCopy(ctx, c); err != nil {
+			return err
+		}
+
+		hold.Status = pb.Hold_READY
+		hold.CopyId = c.Id
+		hold.ReadyAt = timestamppb.New(now)
+		hold.ExpiresAt = timestamppb.New(now.Add(s.lending.PickupWindow))
+		return tx.UpdateHold(ctx, hold)
+	})
+	if err != nil {
+		return nil, toStatus(err, holdResourceType, "holds")
+	}
+	log.Printf("Copy %d set aside for hold %d until %s", hold.CopyId, hold.Id, hold.ExpiresAt.AsTime().Format(time.DateOnly))
+
+	return &pb.FulfillNextHoldResponse{Hold: hold}, nil
+}
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"testing"
+	"time"
+)
+
+func TestListHoldsShowsExpiryWithoutWriting(t *testing.T) {
+	ctx := context.Background()
+	store := newLendingStore(t, 1, 2)
+	holds := NewHoldServer(store, DefaultFeePolicy, DefaultLendingPolicy)
+	now := time.Now()
+	holds.now = func() time.Time { return now }
+
+	if _, err := holds.PlaceHold(ctx, &pb.PlaceHoldRequest{BookId: 1, MemberId: 1}); err != nil {
+		t.Fatal(err)
+	}
+	ready, err := holds.FulfillNextHold(ctx, &pb.FulfillNextHoldRequest{BookId: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored := func() (*pb.Hold, *pb.Copy) {
+		t.Helper()
+		var hold *pb.Hold
+		var c *pb.Copy
+		err := store.RunInTx(ctx, func(tx Tx) (err error) {
+			if hold, err = tx.GetHold(ctx, ready.Hold.Id); err != nil {
+				return err
+			}
+			c, err = tx.GetCopy(ctx, ready.Hold.CopyId)
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return hold, c
+	}
+
+	// Past the pickup deadline the hold is listed as expired, but only a
+	// change to the holds of the book stores it
+	now = now.Add(DefaultLendingPolicy.PickupWindow + time.Hour)
+	active, err := holds.ListHolds(ctx, &pb.ListHoldsRequest{BookId: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(active.Holds) != 0 || active.TotalSize != 0 {
+		t.Errorf("active holds past the deadline: %v", active.Holds)
+	}
+	all, err := holds.ListHolds(ctx, &pb.ListHoldsRequest{BookId: 1, IncludeClosed: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all.Holds) != 1 || all.Holds[0].Status != pb.Hold_EXPIRED || !all.Holds[0].ClosedAt.AsTime().Equal(ready.Hold.ExpiresAt.AsTime()) {
+		t.Errorf("holds past the deadline: %v", all.Holds)
+	}
+	if hold, c := stored(); hold.Status != pb.Hold_READY || c.Status != pb.Copy_ON_HOLD {
+		t.Errorf("listing stored hold %v and copy %v", hold.Status, c.Status)
+	}
+
+	if _, err := holds.PlaceHold(ctx, &pb.PlaceHoldRequest{BookId: 1, MemberId: 2}); err != nil {
+		t.Fatal(err)
+	}
+	hold, c := stored()
+	if hold.Status != pb.Hold_EXPIRED || !hold.ClosedAt.AsTime().Equal(ready.Hold.ExpiresAt.AsTime()) || c.Status != pb.Copy_AVAILABLE {
+		t.Errorf("after placing another hold: stored hold %v closed at %v, copy %v", hold.Status, hold.ClosedAt.AsTime(), c.Status)
+	}
+}
@@ -0,0 +1,164 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"strings"
+)
+
+// holdActive reports whether a hold is still in the queue or awaiting pickup
+func holdActive(hold *pb.Hold) bool {
+	return hold.Status == pb.Hold_WAITING || hold.Status == pb.Hold_READY
+}
+
+// matches reports whether a hold is selected by the filter
+func (f HoldFilter) matches(hold *pb.Hold) bool {
+	return (f.BookID == 0 || hold.BookId == f.BookID) &&
+		(f.MemberID == 0 || hold.MemberId == f.MemberID) &&
+		(!f.ActiveOnly || holdActive(hold))
+}
+
+// In-memory implementation of HoldTx
+
+// CreateHold adds a new hold, failing if its ID is already taken
+func (tx *memTx) CreateHold(ctx context.Context, hold *pb.Hold) error {
+	if _, exists := tx.s.holds.rows[hold.Id]; exists {
+		return ErrAlreadyExists
+	}
+	hold.Revision = 1
+	tx.s.holds.put(tx, hold)
+	return nil
+}
+
+// GetHold returns a copy of the hold with the given ID
+func (tx *memTx) GetHold(ctx context.Context, id int32) (*pb.Hold, error) {
+	return tx.s.holds.get(id)
+}
+
+// UpdateHold replaces an existing hold
+func (tx *memTx) UpdateHold(ctx context.Context, hold *pb.Hold) error {
+	prev, exists := tx.s.holds.rows[hold.Id]
+	if !exists {
+		return ErrNotFound
+	}
+	hold.Revision = prev.Revision + 1
+	tx.s.holds.put(tx, hold)
+	return nil
+}
+
+// ListHolds returns a copy of the matching holds ordered by ID
+func (tx *memTx) ListHolds(ctx context.Context, filter HoldFilter) ([]*pb.Hold, error) {
+	var holds []*pb.Hold
+	for _, hold := range tx.s.holds.list() {
+		if filter.matches(hold) {
+			holds = append(holds, hold)
+		}
+	}
+	return holds, nil
+}
+
+// NextHoldID allocates the next unused hold ID
+func (tx *memTx) NextHoldID(ctx context.Context) (int32, error) {
+	return tx.s.holds.nextID(tx)
+}
+
+// SQL implementation of HoldTx
+
+const holdColumns = `id, book_id, member_id, status, placed_at, copy_id, ready_at, expires_at, closed_at, revision`
+
+// scanHold reads a row selected with holdColumns
+func scanHold(row scanner) (*pb.Hold, error) {
+	hold := &pb.Hold{}
+	var placedAt, readyAt, expiresAt, closedAt sql.NullInt64
+	err := row.Scan(&hold.Id, &hold.BookId, &hold.MemberId, &hold.Status, &placedAt,
+		&hold.CopyId, &readyAt, &expiresAt, &closedAt, &hold.Revision)
+	hold.PlacedAt = timestampOf(placedAt)
+	hold.ReadyAt = timestampOf(readyAt)
+	hold.ExpiresAt = timestampOf(expiresAt)
+	hold.ClosedAt = timestampOf(closedAt)
+	return hold, err
+}
+
+// CreateHold implementation
+func (tx *sqlTx) CreateHold(ctx context.Context, hold *pb.Hold) error {
+	if _, err := tx.GetHold(ctx, hold.Id); err == nil {
+		return ErrAlreadyExists
+	} else if !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	if _, err := tx.q.ExecContext(ctx,
+		`INSERT INTO holds (`+holdColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, 1)`,
+		hold.Id, hold.BookId, hold.MemberId, hold.Status, unixSeconds(hold.PlacedAt), hold.CopyId,
+		unixSeconds(hold.ReadyAt), unixSeconds(hold.ExpiresAt), unixSeconds(hold.ClosedAt)); err != nil {
+		return err
+	}
+	hold.Revision = 1
+	return tx.bumpSequence(ctx, "holds", hold.Id)
+}
+
+// GetHold implementation
+func (tx *sqlTx) GetHold(ctx context.Context, id int32) (*pb.Hold, error) {
+	hold, err := scanHold(tx.q.QueryRowContext(ctx, `SELECT `+holdColumns+` FROM holds WHERE id = ?`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return hold, err
+}
+
+// UpdateHold implementation
+func (tx *sqlTx) UpdateHold(ctx context.Context, hold *pb.Hold) error {
+	err := tx.q.QueryRowContext(ctx,
+		`UPDATE holds SET book_id = ?, member_id = ?, status = ?, placed_at = ?, copy_id = ?, ready_at = ?,
+		expires_at = ?, closed_at = ?, revision = revision + 1 WHERE id = ? RETURNING revision`,
+		hold.BookId, hold.MemberId, hold.Status, unixSeconds(hold.PlacedAt), hold.CopyId, unixSeconds(hold.ReadyAt),
+		unixSeconds(hold.ExpiresAt), unixSeconds(hold.ClosedAt), hold.Id).Scan(&hold.Revision)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// ListHolds implementation
+func (tx *sqlTx) ListHolds(ctx context.Context, filter HoldFilter) ([]*pb.Hold, error) {
+	var where []string
+	var args []any
+	if filter.BookID != 0 {
+		where = append(where, "book_id = ?")
+		args = append(args, filter.BookID)
+	}
+	if filter.MemberID != 0 {
+		where = append(where, "member_id = ?")
+		args = append(args, filter.MemberID)
+	}
+	if filter.ActiveOnly {
+		where = append(where, "status IN (?, ?)")
+		args = append(args, pb.Hold_WAITING, pb.Hold_READY)
+	}
+	query := `SELECT ` + holdColumns + ` FROM holds`
+	if len(where) > 0 {
+		query += ` WHERE ` + strings.Join(where, " AND ")
+	}
+
+	rows, err := tx.q.QueryContext(ctx, query+` ORDER BY id`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var holds []*pb.Hold
+	for rows.Next() {
+		hold, err := scanHold(rows)
+		if err != nil {
+			return nil, err
+		}
+		holds = append(holds, hold)
+	}
+	return holds, rows.Err()
+}
+
+// NextHoldID implementation
+func (tx *sqlTx) NextHoldID(ctx context.Context) (int32, error) {
+	return tx.nextID(ctx, "holds", "holds")
+}
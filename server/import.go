@@ -0,0 +1,166 @@
+package server
+
+import (
+	"context"
+	"errors"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"log"
+)
+
+// Limits for ImportBooks. Books are written in transactions of
+// importBatchSize so that a long import does not pay for a commit per book.
+const (
+	importBatchSize   = 100
+	maxImportFailures = 1000
+)
+
+// importOutcome is what happened to one imported book
+type importOutcome int
+
+const (
+	importFailed importOutcome = iota
+	importCreated
+	importUpdated
+	importSkipped
+)
+
+// importResult is the outcome of importing one book
+type importResult struct {
+	outcome importOutcome
+	reason  string
+}
+
+// ImportBooks implementation. Books are matched to the catalog by ISBN: an
+// unknown ISBN creates a book with a new ID, a known one replaces that
+// book unless nothing changed. Books that cannot be imported are reported in
+// the summary and do not stop the import; only internal errors do, in which
+// case the batches committed so far are kept.
+func (s *LibraryServer) ImportBooks(stream grpc.ClientStreamingServer[pb.Book, pb.ImportBooksResponse]) error {
+	ctx := stream.Context()
+	summary := &pb.ImportBooksResponse{}
+	var pending []*pb.Book
+	next := 0 // Index of pending[0] in the stream
+	for {
+		book, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		pending = append(pending, book)
+		if len(pending) == importBatchSize {
+			if err := s.importBatch(ctx, pending, next, summary); err != nil {
+				return err
+			}
+			next += len(pending)
+			pending = pending[:0]
+		}
+	}
+	if err := s.importBatch(ctx, pending, next, summary); err != nil {
+		return err
+	}
+
+	log.Printf("Books imported: %d created, %d updated, %d skipped, %d failed",
+		summary.Created, summary.Updated, summary.Skipped, summary.Failed)
+	return stream.SendAndClose(summary)
+}
+
+// importBatch imports books in one transaction and adds the outcome to
+// summary. first is the stream index of books[0].
+func (s *LibraryServer) importBatch(ctx context.Context, books []*pb.Book, first int, summary *pb.ImportBooksResponse) error {
+	if len(books) == 0 {
+		return nil
+	}
+
+	var results []importResult
+	err := s.store.RunInTx(ctx, func(tx Tx) error {
+		results = results[:0]
+		for _, book := range books {
+			res, err := importBook(ctx, tx, book)
+			if err != nil {
+				return err
+			}
+			results = append(results, res)
+		}
+		return nil
+	})
+	if err != nil {
+		return toStatus(err, bookResourceType, "books")
+	}
+
+	for i, res := range results {
+		switch res.outcome {
+		case importCreated:
+			summary.Created++
+		case importUpdated:
+			summary.Updated++
+		case importSkipped:
+			summary.Skipped++
+		case importFailed:
+			summary.Failed++
+			if len(summary.Failures) < maxImportFailures {
+				summary.Failures = append(summary.Failures, &pb.ImportFailure{
+					Index:  int32(first + i),
+					Isbn:   books[i].GetIsbn(),
+					Reason: res.reason,
+				})
+			}
+		}
+	}
+	return nil
+}
+
+// importBook creates or updates the book with the ISBN of in within tx.
+// Rejections are reported in the result; an error means the transaction can
+// no longer be used.
+func importBook(ctx context.Context, tx Tx, in *pb.Book) (importResult, error) {
+	book, err := prepareBook(in)
+	if err != nil {
+		return importResult{reason: status.Convert(err).Message()}, nil
+	}
+	if book.Isbn == "" {
+		return importResult{reason: "book.isbn: isbn is required to import a book"}, nil
+	}
+
+	outcome := importCreated
+	existing, err := tx.FindBookByISBN(ctx, book.Isbn)
+	switch {
+	case err == nil:
+		book.Id, book.Revision = existing.Id, existing.Revision
+		// Catalog feeds know nothing of author and branch IDs, so keep
+		// the links made in the library
+		if len(book.Authors) == 0 {
+			book.Authors = existing.Authors
+		}
+		if len(book.BranchIds) == 0 {
+			book.BranchIds = existing.BranchIds
+		}
+		// Linking derives the author from the links, as it was for the
+		// stored book, so only then can the two be compared
+		if err = linkBook(ctx, tx, book); err != nil {
+			break
+		}
+		if proto.Equal(book, existing) {
+			return importResult{outcome: importSkipped}, nil
+		}
+		outcome = importUpdated
+		err = tx.UpdateBook(ctx, book)
+	case errors.Is(err, ErrNotFound):
+		book.Id, book.Revision = 0, 0
+		err = createBook(ctx, tx, book)
+	}
+	if err != nil {
+		st := status.Convert(bookError(err, book.Id))
+		if st.Code() == codes.Internal {
+			return importResult{}, st.Err()
+		}
+		return importResult{reason: st.Message()}, nil
+	}
+	return importResult{outcome: outcome}, nil
+}
@@ -0,0 +1,151 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"google.golang.org/grpc"
+	"io"
+	"testing"
+)
+
+// fakeImportStream feeds books to ImportBooks and keeps its summary
+type fakeImportStream struct {
+	grpc.ServerStream
+	books   []*pb.Book
+	summary *pb.ImportBooksResponse
+}
+
+func (f *fakeImportStream) Context() context.Context { return context.Background() }
+
+func (f *fakeImportStream) Recv() (*pb.Book, error) {
+	if len(f.books) == 0 {
+		return nil, io.EOF
+	}
+	book := f.books[0]
+	f.books = f.books[1:]
+	return book, nil
+}
+
+func (f *fakeImportStream) SendAndClose(summary *pb.ImportBooksResponse) error {
+	f.summary = summary
+	return nil
+}
+
+// txCountingStore counts the transactions run through it
+type txCountingStore struct {
+	Store
+	txs int
+}
+
+func (s *txCountingStore) RunInTx(ctx context.Context, fn func(tx Tx) error) error {
+	s.txs++
+	return s.Store.RunInTx(ctx, fn)
+}
+
+// testISBN returns a valid ISBN-13 for n
+func testISBN(n int) string {
+	digits := []byte(fmt.Sprintf("978%09d", n))
+	return string(append(digits, isbn13CheckDigit(digits)))
+}
+
+// importBooks runs ImportBooks over books and returns its summary
+func importBooks(t *testing.T, s *LibraryServer, books []*pb.Book) *pb.ImportBooksResponse {
+	t.Helper()
+	stream := &fakeImportStream{books: books}
+	if err := s.ImportBooks(stream); err != nil {
+		t.Fatal(err)
+	}
+	return stream.summary
+}
+
+func TestImportBooks(t *testing.T) {
+	ctx := context.Background()
+	store := &txCountingStore{Store: NewBookStore()}
+	s, err := NewLibraryServer(store, DefaultWatchHistory)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 250 books over three transactions, with rejected rows on both sides
+	// of a batch boundary
+	var books []*pb.Book
+	for i := 0; i < 250; i++ {
+		books = append(books, &pb.Book{Title: fmt.Sprintf("Book %d", i), Isbn: testISBN(i)})
+	}
+	books[99].Isbn = ""
+	books[100].Isbn = "9780000000000"
+	books[101] = &pb.Book{Title: "Copy of book 0", Isbn: testISBN(0)}
+	summary := importBooks(t, s, books)
+	if summary.Created != 247 || summary.Updated != 1 || summary.Skipped != 0 || summary.Failed != 2 {
+		t.Errorf("first import: %v", summary)
+	}
+	if len(summary.Failures) != 2 || summary.Failures[0].Index != 99 || summary.Failures[1].Index != 100 {
+		t.Errorf("failures %v, want rows 99 and 100", summary.Failures)
+	}
+	if store.txs != 3 {
+		t.Errorf("import ran %d transactions, want 3", store.txs)
+	}
+	// Rejected rows leave no gap in the IDs
+	if book, err := store.FindBookByISBN(ctx, testISBN(249)); err != nil || book.Id != 247 {
+		t.Errorf("last book: %v, %v, want ID 247", book, err)
+	}
+
+	// Importing the same rows again changes nothing
+	summary = importBooks(t, s, books[102:])
+	if summary.Created != 0 || summary.Updated != 0 || summary.Skipped != 148 || summary.Failed != 0 {
+		t.Errorf("second import: %v", summary)
+	}
+	book, err := store.FindBookByISBN(ctx, testISBN(150))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if book.Revision != 1 {
+		t.Errorf("skipped book has revision %d, want 1", book.Revision)
+	}
+
+	// A changed row is updated in place, whatever revision the feed sends
+	summary = importBooks(t, s, []*pb.Book{{Title: "Renamed", Isbn: testISBN(150), Revision: 42}})
+	if summary.Updated != 1 {
+		t.Errorf("changed book: %v", summary)
+	}
+	if after, err := store.GetBook(ctx, book.Id); err != nil || after.Title != "Renamed" || after.Revision != 2 {
+		t.Errorf("updated book: %v, %v", after, err)
+	}
+}
+
+func TestImportBooksWithAuthorLinks(t *testing.T) {
+	ctx := context.Background()
+	store := NewBookStore()
+	s, err := NewLibraryServer(store, DefaultWatchHistory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	authors := NewAuthorServer(store)
+	author, err := authors.CreateAuthor(ctx, &pb.CreateAuthorRequest{Author: &pb.Author{Name: "Frank Herbert"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	created, err := s.CreateBook(ctx, &pb.CreateBookRequest{Book: &pb.Book{
+		Title:   "Dune",
+		Isbn:    testISBN(1),
+		Authors: []*pb.BookAuthor{{AuthorId: author.Author.Id}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The feed spells the author its own way, but the stored author comes
+	// from the link, so nothing changed
+	summary := importBooks(t, s, []*pb.Book{{Title: "Dune", Author: "Herbert, Frank", Isbn: testISBN(1)}})
+	if summary.Skipped != 1 {
+		t.Errorf("import of a linked book: %v, want it skipped", summary)
+	}
+	book, err := store.GetBook(ctx, created.Book.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if book.Revision != 1 || book.Author != "Frank Herbert" {
+		t.Errorf("linked book after import: %v", book)
+	}
+}
@@ -0,0 +1,71 @@
+package server
+
+import (
+	"errors"
+	"strings"
+)
+
+// Errors returned by normalizeISBN
+var (
+	errISBNLength   = errors.New("isbn must have 10 or 13 digits")
+	errISBNChars    = errors.New("isbn may only contain digits, hyphens, spaces and a final X in ISBN-10")
+	errISBNChecksum = errors.New("isbn check digit is wrong")
+)
+
+// normalizeISBN validates an ISBN-10 or ISBN-13, ignoring hyphens and
+// spaces, and returns it as 13 digits without separators
+func normalizeISBN(s string) (string, error) {
+	var digits []byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= '0' && c <= '9':
+			digits = append(digits, c)
+		case c == 'X' || c == 'x':
+			digits = append(digits, 'X')
+		case c == '-' || c == ' ':
+		default:
+			return "", errISBNChars
+		}
+	}
+	if i := strings.IndexByte(string(digits), 'X'); i >= 0 && (len(digits) != 10 || i != 9) {
+		return "", errISBNChars
+	}
+
+	switch len(digits) {
+	case 10:
+		sum := 0
+		for i, c := range digits {
+			d := int(c - '0')
+			if c == 'X' {
+				d = 10
+			}
+			sum += (10 - i) * d
+		}
+		if sum%11 != 0 {
+			return "", errISBNChecksum
+		}
+		isbn13 := append([]byte("978"), digits[:9]...)
+		return string(append(isbn13, isbn13CheckDigit(isbn13))), nil
+	case 13:
+		if isbn13CheckDigit(digits[:12]) != digits[12] {
+			return "", errISBNChecksum
+		}
+		return string(digits), nil
+	}
+	return "", errISBNLength
+}
+
+// isbn13CheckDigit computes the check digit of the first 12 digits of an
+// ISBN-13
+func isbn13CheckDigit(digits []byte) byte {
+	sum := 0
+	for i, c := range digits[:12] {
+		w := 1
+		if i%2 == 1 {
+			w = 3
+		}
+		sum += w * int(c-'0')
+	}
+	return byte('0' + (10-sum%10)%10)
+}
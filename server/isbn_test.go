@@ -0,0 +1,38 @@
+package server
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNormalizeISBN(t *testing.T) {
+	tests := []struct {
+		isbn    string
+		want    string
+		wantErr error
+	}{
+		{isbn: "9780441013593", want: "9780441013593"},
+		{isbn: "978-0-441-01359-3", want: "9780441013593"},
+		{isbn: "978 0 441 01359 3", want: "9780441013593"},
+		{isbn: "0441013597", want: "9780441013593"},
+		{isbn: "0-441-01359-7", want: "9780441013593"},
+		{isbn: "080442957X", want: "9780804429573"},
+		{isbn: "080442957x", want: "9780804429573"},
+		{isbn: "9780306406157", want: "9780306406157"},
+		{isbn: "9780441013594", wantErr: errISBNChecksum},
+		{isbn: "0441013598", wantErr: errISBNChecksum},
+		{isbn: "0804429579", wantErr: errISBNChecksum},
+		{isbn: "X804429570", wantErr: errISBNChars},
+		{isbn: "978044101359X", wantErr: errISBNChars},
+		{isbn: "978-0441_013593", wantErr: errISBNChars},
+		{isbn: "044101359", wantErr: errISBNLength},
+		{isbn: "97804410135930", wantErr: errISBNLength},
+		{isbn: "---", wantErr: errISBNLength},
+	}
+	for _, tc := range tests {
+		got, err := normalizeISBN(tc.isbn)
+		if !errors.Is(err, tc.wantErr) || got != tc.want {
+			t.Errorf("normalizeISBN(%q) = %q, %v; want %q, %v", tc.isbn, got, err, tc.want, tc.wantErr)
+		}
+	}
+}
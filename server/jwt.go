@@ -0,0 +1,342 @@
+package server
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	_ "crypto/sha256" // registers SHA-256 for crypto.Hash
+	_ "crypto/sha512" // registers SHA-384 and SHA-512 for crypto.Hash
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+// jwtLeeway is the clock skew tolerated when checking token times
+const jwtLeeway = time.Minute
+
+// jwtAlgorithm describes a supported JWS signature algorithm
+type jwtAlgorithm struct {
+	hash  crypto.Hash
+	kty   string // Key type in the JWKS
+	curve elliptic.Curve
+	pss   bool
+}
+
+// jwtAlgorithms are the signature algorithms accepted in tokens. "none" and
+// HMAC algorithms are deliberately missing: tokens must be signed with a key
+// published in the JWKS.
+var jwtAlgorithms = map[string]jwtAlgorithm{
+	"RS256": {hash: crypto.SHA256, kty: "RSA"},
+	"RS384": {hash: crypto.SHA384, kty: "RSA"},
+	"RS512": {hash: crypto.SHA512, kty: "RSA"},
+	"PS256": {hash: crypto.SHA256, kty: "RSA", pss: true},
+	"PS384": {hash: crypto.SHA384, kty: "RSA", pss: true},
+	"PS512": {hash: crypto.SHA512, kty: "RSA", pss: true},
+	"ES256": {hash: crypto.SHA256, kty: "EC", curve: elliptic.P256()},
+	"ES384": {hash: crypto.SHA384, kty: "EC", curve: elliptic.P384()},
+	"ES512": {hash: crypto.SHA512, kty: "EC", curve: elliptic.P521()},
+	"EdDSA": {kty: "OKP"},
+}
+
+// jwk is a public key read from a JWKS file
+type jwk struct {
+	kid string
+	alg string // Algorithm the key is restricted to, if any
+	kty string
+	key crypto.PublicKey
+}
+
+// jwks is a set of public keys used to verify tokens
+type jwks []*jwk
+
+// rawJWK is the JSON form of a key, per RFC 7517 and RFC 7518
+type rawJWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// bigInt decodes a base64url-encoded unsigned integer
+func bigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(b) == 0 {
+		return nil, errors.New("malformed integer")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+// publicKey decodes the key material of a JWK
+func (r *rawJWK) publicKey() (crypto.PublicKey, error) {
+	switch r.Kty {
+	case "RSA":
+		n, err := bigInt(r.N)
+		if err != nil {
+			return nil, fmt.Errorf("n: %w", err)
+		}
+		e, err := bigInt(r.E)
+		if err != nil || !e.IsInt64() || e.Int64() < 3 || e.Int64() > 1<<31-1 {
+			return nil, errors.New("e: malformed exponent")
+		}
+		if n.BitLen() < 2048 {
+			return nil, errors.New("n: RSA keys must be at least 2048 bits")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch r.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("crv: unsupported curve %q", r.Crv)
+		}
+		x, err := bigInt(r.X)
+		if err != nil {
+			return nil, fmt.Errorf("x: %w", err)
+		}
+		y, err := bigInt(r.Y)
+		if err != nil {
+			return nil, fmt.Errorf("y: %w", err)
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("point is not on the curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "OKP":
+		if r.Crv != "Ed25519" {
+			return nil, fmt.Errorf("crv: unsupported curve %q", r.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(r.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("x: malformed key")
+		}
+		return ed25519.PublicKey(x), nil
+	}
+	return nil, fmt.Errorf("kty: unsupported key type %q", r.Kty)
+}
+
+// parseJWKS decodes a JSON Web Key Set. Keys meant for encryption are
+// skipped; any other key that cannot be used fails the whole set, so that a
+// typo does not silently lock clients out.
+func parseJWKS(data []byte) (jwks, error) {
+	var doc struct {
+		Keys []rawJWK `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	var keys jwks
+	for i, raw := range doc.Keys {
+		if raw.Use != "" && raw.Use != "sig" {
+			continue
+		}
+		if raw.Alg != "" {
+			alg, ok := jwtAlgorithms[raw.Alg]
+			if !ok || alg.kty != raw.Kty {
+				return nil, fmt.Errorf("keys[%d]: unsupported algorithm %q for key type %q", i, raw.Alg, raw.Kty)
+			}
+		}
+		key, err := raw.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("keys[%d]: %w", i, err)
+		}
+		keys = append(keys, &jwk{kid: raw.Kid, alg: raw.Alg, kty: raw.Kty, key: key})
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no signing keys")
+	}
+	return keys, nil
+}
+
+// loadJWKS reads a JSON Web Key Set file
+func loadJWKS(path string) (jwks, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return keys, nil
+}
+
+// find returns the key a token header refers to. Tokens without a key ID
+// are only accepted when a single key could have signed them.
+func (keys jwks) find(kid, alg string, spec jwtAlgorithm) (*jwk, error) {
+	var found *jwk
+	for _, k := range keys {
+		if k.kty != spec.kty || (k.alg != "" && k.alg != alg) || (kid != "" && k.kid != kid) {
+			continue
+		}
+		if found != nil {
+			return nil, errors.New("token does not say which key signed it")
+		}
+		found = k
+	}
+	if found == nil {
+		return nil, errors.New("token is signed with an unknown key")
+	}
+	return found, nil
+}
+
+// verify checks a signature made with alg over signed
+func (k *jwk) verify(spec jwtAlgorithm, signed, sig []byte) bool {
+	if k.kty == "OKP" {
+		return ed25519.Verify(k.key.(ed25519.PublicKey), signed, sig)
+	}
+	h := spec.hash.New()
+	h.Write(signed)
+	digest := h.Sum(nil)
+
+	switch key := k.key.(type) {
+	case *rsa.PublicKey:
+		if spec.pss {
+			return rsa.VerifyPSS(key, spec.hash, digest, sig, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash}) == nil
+		}
+		return rsa.VerifyPKCS1v15(key, spec.hash, digest, sig) == nil
+	case *ecdsa.PublicKey:
+		size := (key.Curve.Params().BitSize + 7) / 8
+		if key.Curve != spec.curve || len(sig) != 2*size {
+			return false
+		}
+		r := new(big.Int).SetBytes(sig[:size])
+		s := new(big.Int).SetBytes(sig[size:])
+		return ecdsa.Verify(key, digest, r, s)
+	}
+	return false
+}
+
+// audience is the "aud" claim, which is either a string or an array
+type audience []string
+
+// UnmarshalJSON accepts both forms of the claim
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var one string
+	if err := json.Unmarshal(data, &one); err == nil {
+		*a = audience{one}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return errors.New("aud must be a string or an array of strings")
+	}
+	*a = many
+	return nil
+}
+
+// jwtClaims are the registered and library claims read from a token. Roles
+// come from the "roles" claim or, failing that, the space-separated
+// "scope" claim. The "member_id" claim links the caller to a member.
+type jwtClaims struct {
+	Subject   string   `json:"sub"`
+	Issuer    string   `json:"iss"`
+	Audience  audience `json:"aud"`
+	ExpiresAt *float64 `json:"exp"`
+	NotBefore *float64 `json:"nbf"`
+	Roles     []string `json:"roles"`
+	Scope     string   `json:"scope"`
+	MemberID  int32    `json:"member_id"`
+}
+
+// jwtVerifier checks bearer tokens against a JWKS and the expected issuer
+// and audience
+type jwtVerifier struct {
+	keys     jwks
+	issuer   string // Required "iss" claim, if set
+	audience string // Required member of the "aud" claim, if set
+}
+
+// decodeSegment decodes one base64url part of a token
+func decodeSegment(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(s)
+}
+
+// verify checks the signature and claims of a compact-serialized token at
+// time now and returns its claims
+func (v *jwtVerifier) verify(token string, now time.Time) (*jwtClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token")
+	}
+	headerJSON, err := decodeSegment(parts[0])
+	if err != nil {
+		return nil, errors.New("malformed token header")
+	}
+	var header struct {
+		Alg  string   `json:"alg"`
+		Kid  string   `json:"kid"`
+		Crit []string `json:"crit"`
+	}
+	if err := json.Unmarshal(headerJSON, &header); err != nil {
+		return nil, errors.New("malformed token header")
+	}
+	if len(header.Crit) > 0 {
+		return nil, errors.New("token has unsupported critical header parameters")
+	}
+	spec, ok := jwtAlgorithms[header.Alg]
+	if !ok {
+		return nil, fmt.Errorf("unsupported token algorithm %q", header.Alg)
+	}
+	key, err := v.keys.find(header.Kid, header.Alg, spec)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := decodeSegment(parts[2])
+	if err != nil {
+		return nil, errors.New("malformed token signature")
+	}
+	if !key.verify(spec, []byte(parts[0]+"."+parts[1]), sig) {
+		return nil, errors.New("invalid token signature")
+	}
+
+	payload, err := decodeSegment(parts[1])
+	if err != nil {
+		return nil, errors.New("malformed token payload")
+	}
+	claims := &jwtClaims{}
+	if err := json.Unmarshal(payload, claims); err != nil {
+		return nil, fmt.Errorf("malformed token claims: %v", err)
+	}
+
+	switch {
+	case claims.Subject == "":
+		return nil, errors.New("token has no subject")
+	case claims.ExpiresAt == nil:
+		return nil, errors.New("token has no expiry")
+	case now.After(unixTime(*claims.ExpiresAt).Add(jwtLeeway)):
+		return nil, errors.New("token has expired")
+	case claims.NotBefore != nil && now.Add(jwtLeeway).Before(unixTime(*claims.NotBefore)):
+		return nil, errors.New("token is not valid yet")
+	case v.issuer != "" && claims.Issuer != v.issuer:
+		return nil, errors.New("token has the wrong issuer")
+	case v.audience != "" && !slices.Contains(claims.Audience, v.audience):
+		return nil, errors.New("token is not meant for this server")
+	}
+	if claims.Roles == nil && claims.Scope != "" {
+		claims.Roles = strings.Fields(claims.Scope)
+	}
+	return claims, nil
+}
+
+// unixTime converts a NumericDate claim to a time
+func unixTime(seconds float64) time.Time {
+	return time.Unix(0, 0).Add(time.Duration(seconds * float64(time.Second)))
+}
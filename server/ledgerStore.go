@@ -0,0 +1,127 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"strings"
+)
+
+// matches reports whether a ledger entry is selected by the filter
+func (f LedgerFilter) matches(entry *pb.LedgerEntry) bool {
+	return (f.MemberID == 0 || entry.MemberId == f.MemberID) &&
+		(f.LoanID == 0 || entry.LoanId == f.LoanID) &&
+		(f.RelatedID == 0 || entry.RelatedId == f.RelatedID)
+}
+
+// In-memory implementation of LedgerTx
+
+// CreateLedgerEntry adds a new entry, failing if its ID is already taken
+func (tx *memTx) CreateLedgerEntry(ctx context.Context, entry *pb.LedgerEntry) error {
+	if _, exists := tx.s.ledger.rows[entry.Id]; exists {
+		return ErrAlreadyExists
+	}
+	tx.s.ledger.put(tx, entry)
+	return nil
+}
+
+// GetLedgerEntry returns a copy of the entry with the given ID
+func (tx *memTx) GetLedgerEntry(ctx context.Context, id int32) (*pb.LedgerEntry, error) {
+	return tx.s.ledger.get(id)
+}
+
+// ListLedgerEntries returns a copy of the matching entries ordered by ID
+func (tx *memTx) ListLedgerEntries(ctx context.Context, filter LedgerFilter) ([]*pb.LedgerEntry, error) {
+	var entries []*pb.LedgerEntry
+	for _, entry := range tx.s.ledger.list() {
+		if filter.matches(entry) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+// NextLedgerEntryID allocates the next unused entry ID
+func (tx *memTx) NextLedgerEntryID(ctx context.Context) (int32, error) {
+	return tx.s.ledger.nextID(tx)
+}
+
+// SQL implementation of LedgerTx
+
+const ledgerColumns = `id, member_id, type, reason, amount, book_id, loan_id, related_id, note, created_at`
+
+// scanLedgerEntry reads a row selected with ledgerColumns
+func scanLedgerEntry(row scanner) (*pb.LedgerEntry, error) {
+	entry := &pb.LedgerEntry{}
+	var createdAt sql.NullInt64
+	err := row.Scan(&entry.Id, &entry.MemberId, &entry.Type, &entry.Reason, &entry.Amount,
+		&entry.BookId, &entry.LoanId, &entry.RelatedId, &entry.Note, &createdAt)
+	entry.CreatedAt = timestampOf(createdAt)
+	return entry, err
+}
+
+// CreateLedgerEntry implementation
+func (tx *sqlTx) CreateLedgerEntry(ctx context.Context, entry *pb.LedgerEntry) error {
+	if _, err := tx.GetLedgerEntry(ctx, entry.Id); err == nil {
+		return ErrAlreadyExists
+	} else if !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	if _, err := tx.q.ExecContext(ctx,
+		`INSERT INTO ledger (`+ledgerColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		entry.Id, entry.MemberId, entry.Type, entry.Reason, entry.Amount, entry.BookId,
+		entry.LoanId, entry.RelatedId, entry.Note, unixSeconds(entry.CreatedAt)); err != nil {
+		return err
+	}
+	return tx.bumpSequence(ctx, "ledger", entry.Id)
+}
+
+// GetLedgerEntry implementation
+func (tx *sqlTx) GetLedgerEntry(ctx context.Context, id int32) (*pb.LedgerEntry, error) {
+	entry, err := scanLedgerEntry(tx.q.QueryRowContext(ctx, `SELECT `+ledgerColumns+` FROM ledger WHERE id = ?`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return entry, err
+}
+
+// ListLedgerEntries implementation
+func (tx *sqlTx) ListLedgerEntries(ctx context.Context, filter LedgerFilter) ([]*pb.LedgerEntry, error) {
+	var where []string
+	var args []any
+	for _, c := range []struct {
+		column string
+		value  int32
+	}{{"member_id", filter.MemberID}, {"loan_id", filter.LoanID}, {"related_id", filter.RelatedID}} {
+		if c.value != 0 {
+			where = append(where, c.column+" = ?")
+			args = append(args, c.value)
+		}
+	}
+	query := `SELECT ` + ledgerColumns + ` FROM ledger`
+	if len(where) > 0 {
+		query += ` WHERE ` + strings.Join(where, " AND ")
+	}
+
+	rows, err := tx.q.QueryContext(ctx, query+` ORDER BY id`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*pb.LedgerEntry
+	for rows.Next() {
+		entry, err := scanLedgerEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
+
+// NextLedgerEntryID implementation
+func (tx *sqlTx) NextLedgerEntryID(ctx context.Context) (int32, error) {
+	return tx.nextID(ctx, "ledger", "ledger")
+}
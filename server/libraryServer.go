@@ -2,104 +2,393 @@ package server
 
 import (
 	"context"
+	"fmt"
 	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
-	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
 	"log"
-	"sync"
+	"strconv"
+	"time"
 )
 
-// BookStore struct to hold the in-memory storage
-type BookStore struct {
-	books map[int32]*pb.Book
-	mu    sync.Mutex // Mutex to handle concurrent access
-}
-
 // LibraryServer is used to implement the LibraryService
 type LibraryServer struct {
 	pb.UnimplementedLibraryServiceServer
-	store *BookStore
+	store Store
+
+	index *searchIndex
+	feed  *changeFeed
 }
 
-// NewLibraryServer Create a new LibraryServer with the BookStore
+// NewLibraryServer Create a new LibraryServer backed by the given Store,
+// keeping the last watchHistory book events for resuming watchers
 
-func NewLibraryServer() *LibraryServer {
-	return &LibraryServer{
-		store: &BookStore{
-			books: make(map[int32]*pb.Book),
-		},
+func NewLibraryServer(store Store, watchHistory int) (*LibraryServer, error) {
+	epoch, err := store.NextFeedEpoch(context.Background(), feedEpoch(time.Now()))
+	if err != nil {
+		return nil, fmt.Errorf("start the change feed: %w", err)
 	}
+	s := &LibraryServer{
+		store: store,
+		index: newSearchIndex(),
+		feed:  newChangeFeed(epoch, watchHistory),
+	}
+
+	// Build the search index from the books already stored, then keep it
+	// current from the changes of every committed transaction
+	books, err := store.ListBooks(context.Background())
+	if err != nil {
+		log.Printf("Failed to build search index: %v", err)
+	}
+	for _, book := range books {
+		s.index.put(book)
+	}
+	store.ObserveBooks(s.booksChanged)
+	return s, nil
 }
 
-// CreateBook implementation
-func (s *LibraryServer) CreateBook(ctx context.Context, req *pb.CreateBookRequest) (*pb.CreateBookResponse, error) {
-	s.store.mu.Lock()
-	defer s.store.mu.Unlock()
+// booksChanged updates the search index with the book changes of a
+// committed transaction and publishes them to watchers. The store calls it
+// in commit order, so events are numbered in that order and none is lost or
+// merged with another when mutations race.
+func (s *LibraryServer) booksChanged(changes []BookChange) {
+	for _, c := range changes {
+		ev := &pb.BookEvent{Before: c.Before, After: c.After}
+		switch {
+		case c.Before == nil:
+			ev.Type = pb.BookEvent_CREATED
+			s.index.put(c.After)
+		case c.After == nil:
+			ev.Type = pb.BookEvent_DELETED
+			s.index.remove(c.Before.Id)
+		default:
+			ev.Type = pb.BookEvent_UPDATED
+			s.index.put(c.After)
+		}
+		s.feed.publish(ev)
+	}
+}
+
+// normalizeBook validates the fields of a book sent by a client and puts
+// them in canonical form
+func normalizeBook(book *pb.Book) error {
+	if book.Isbn != "" {
+		isbn, err := normalizeISBN(book.Isbn)
+		if err != nil {
+			return fieldError(codes.InvalidArgument, "book.isbn", err.Error())
+		}
+		book.Isbn = isbn
+	}
+	return nil
+}
+
+// checkRevision fails with ErrRevisionMismatch unless expected is zero or
+// the current revision of the book. Called inside the transaction that
+// changes the book, it gives compare-and-swap semantics on every Store.
+func checkRevision(ctx context.Context, tx Tx, id int32, expected int64) error {
+	if expected == 0 {
+		return nil
+	}
+	current, err := tx.GetBook(ctx, id)
+	if err != nil {
+		return err
+	}
+	if current.Revision != expected {
+		return ErrRevisionMismatch
+	}
+	return nil
+}
 
-	if _, exists := s.store.books[req.Book.Id]; exists {
-		return nil, status.Error(400, "book with the given ID already exists")
+// prepareBook returns a normalized copy of a book sent by a client
+func prepareBook(book *pb.Book) (*pb.Book, error) {
+	if book == nil {
+		return nil, fieldError(codes.InvalidArgument, "book", "book is required")
+	}
+	book = proto.Clone(book).(*pb.Book)
+	if err := normalizeBook(book); err != nil {
+		return nil, err
 	}
+	return book, nil
+}
 
-	// Add the book to the store
-	s.store.books[req.Book.Id] = req.Book
-	log.Printf("Book added: %v", req.Book)
+// linkBook validates the authors and branches a book is linked to within tx
+func linkBook(ctx context.Context, tx Tx, book *pb.Book) error {
+	if err := linkAuthors(ctx, tx, book); err != nil {
+		return err
+	}
+	return checkBookBranches(ctx, tx, book)
+}
 
-	return &pb.CreateBookResponse{Book: req.Book}, nil
+// createBook adds a book within tx. The next ID is assigned when the book
+// has none.
+func createBook(ctx context.Context, tx Tx, book *pb.Book) error {
+	if err := linkBook(ctx, tx, book); err != nil {
+		return err
+	}
+	if book.Id == 0 {
+		id, err := tx.NextBookID(ctx)
+		if err != nil {
+			return err
+		}
+		book.Id = id
+	}
+	return tx.CreateBook(ctx, book)
 }
 
-// GetBook implementation
-func (s *LibraryServer) GetBook(ctx context.Context, req *pb.GetBookRequest) (*pb.GetBookResponse, error) {
-	s.store.mu.Lock()
-	defer s.store.mu.Unlock()
+// updateBook replaces a book within tx if its revision matches
+func updateBook(ctx context.Context, tx Tx, book *pb.Book) error {
+	if err := checkRevision(ctx, tx, book.Id, book.Revision); err != nil {
+		return err
+	}
+	if err := linkBook(ctx, tx, book); err != nil {
+		return err
+	}
+	return tx.UpdateBook(ctx, book)
+}
+
+// deleteBook removes a book within tx if its revision matches, all its
+// copies are retired and no member is waiting for it
+func deleteBook(ctx context.Context, tx Tx, id int32, revision int64) error {
+	if err := checkRevision(ctx, tx, id, revision); err != nil {
+		return err
+	}
+	a, err := availability(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+	if a.Total > 0 {
+		return ErrBookHasCopies
+	}
+	if a.HoldsWaiting > 0 {
+		return preconditionError("ACTIVE_HOLDS", bookName(id), "members are waiting for this book")
+	}
+	return tx.DeleteBook(ctx, id)
+}
 
-	book, exists := s.store.books[req.Id]
-	if !exists {
-		return nil, status.Error(404, "book not found")
+// CreateBook implementation. The server assigns the next ID when the
+// request leaves it empty.
+func (s *LibraryServer) CreateBook(ctx context.Context, req *pb.CreateBookRequest) (*pb.CreateBookResponse, error) {
+	book, err := prepareBook(req.Book)
+	if err != nil {
+		return nil, err
+	}
+	err = s.store.RunInTx(ctx, func(tx Tx) error { return createBook(ctx, tx, book) })
+	if err != nil {
+		return nil, bookError(err, book.Id)
 	}
+	log.Printf("Book added: %v", book)
 
-	return &pb.GetBookResponse{Book: book}, nil
+	return &pb.CreateBookResponse{Book: book}, nil
+}
+
+// GetBook implementation. The response also counts the copies of the book.
+func (s *LibraryServer) GetBook(ctx context.Context, req *pb.GetBookRequest) (*pb.GetBookResponse, error) {
+	resp := &pb.GetBookResponse{}
+	err := s.store.RunInTx(ctx, func(tx Tx) (err error) {
+		if resp.Book, err = tx.GetBook(ctx, req.Id); err != nil {
+			return err
+		}
+		resp.Availability, err = availability(ctx, tx, req.Id)
+		return err
+	})
+	if err != nil {
+		return nil, bookError(err, req.Id)
+	}
+
+	return resp, nil
 }
 
 // UpdateBook implementation
 func (s *LibraryServer) UpdateBook(ctx context.Context, req *pb.UpdateBookRequest) (*pb.UpdateBookResponse, error) {
-	s.store.mu.Lock()
-	defer s.store.mu.Unlock()
+	book, err := prepareBook(req.Book)
+	if err != nil {
+		return nil, err
+	}
+	err = s.store.RunInTx(ctx, func(tx Tx) error { return updateBook(ctx, tx, book) })
+	if err != nil {
+		return nil, bookError(err, book.Id)
+	}
+	log.Printf("Book updated: %v", book)
 
-	if _, exists := s.store.books[req.Book.Id]; !exists {
-		return nil, status.Error(404, "book not found")
+	return &pb.UpdateBookResponse{Book: book}, nil
+}
+
+// PatchBook implementation. The current book is read and written back in
+// one transaction, so fields outside the mask are never overwritten with
+// stale values.
+func (s *LibraryServer) PatchBook(ctx context.Context, req *pb.PatchBookRequest) (*pb.PatchBookResponse, error) {
+	if req.Book == nil {
+		return nil, fieldError(codes.InvalidArgument, "book", "book is required")
 	}
 
-	// Update the book
-	s.store.books[req.Book.Id] = req.Book
-	log.Printf("Book updated: %v", req.Book)
+	var book *pb.Book
+	err := s.store.RunInTx(ctx, func(tx Tx) error {
+		current, err := tx.GetBook(ctx, req.Book.Id)
+		if err != nil {
+			return err
+		}
+		if req.Book.Revision != 0 && req.Book.Revision != current.Revision {
+			return ErrRevisionMismatch
+		}
+		isbn := current.Isbn
+		if err := applyBookMask(current, req.Book, req.UpdateMask); err != nil {
+			return fieldError(codes.InvalidArgument, "update_mask", err.Error())
+		}
+		// Only validate the ISBN when it changes, so that books stored
+		// before validation existed can still be patched
+		if current.Isbn != isbn {
+			if err := normalizeBook(current); err != nil {
+				return err
+			}
+		}
+		if err := linkBook(ctx, tx, current); err != nil {
+			return err
+		}
+		book = current
+		return tx.UpdateBook(ctx, book)
+	})
+	if err != nil {
+		return nil, bookError(err, req.Book.Id)
+	}
+	log.Printf("Book patched: %v", book)
 
-	return &pb.UpdateBookResponse{Book: req.Book}, nil
+	return &pb.PatchBookResponse{Book: book}, nil
 }
 
 // DeleteBook implementation
 func (s *LibraryServer) DeleteBook(ctx context.Context, req *pb.DeleteBookRequest) (*pb.DeleteBookResponse, error) {
-	s.store.mu.Lock()
-	defer s.store.mu.Unlock()
-
-	if _, exists := s.store.books[req.Id]; !exists {
-		return nil, status.Error(404, "book not found")
+	err := s.store.RunInTx(ctx, func(tx Tx) error { return deleteBook(ctx, tx, req.Id, req.Revision) })
+	if err != nil {
+		return nil, bookError(err, req.Id)
 	}
-
-	// Delete the book
-	delete(s.store.books, req.Id)
 	log.Printf("Book deleted: %v", req.Id)
 
 	return &pb.DeleteBookResponse{Success: true}, nil
 }
 
-// ListBooks implementation
+// Page sizes for ListBooks
+const (
+	defaultPageSize = 50
+	maxPageSize     = 1000
+)
+
+// listScanSize is the number of books ListBooks reads from the store at a
+// time
+const listScanSize = 500
+
+// scanBooks calls fn with every book with an ID greater than afterID in ID
+// order, reading the store a page at a time, until fn returns false
+func scanBooks(ctx context.Context, store Store, afterID int32, fn func(book *pb.Book) bool) error {
+	for {
+		books, err := store.ListBooksAfter(ctx, afterID, listScanSize)
+		if err != nil || len(books) == 0 {
+			return err
+		}
+		for _, book := range books {
+			if !fn(book) {
+				return nil
+			}
+		}
+		afterID = books[len(books)-1].Id
+	}
+}
+
+// ListBooks implementation. Pages are delimited by the sort key of the last
+// book returned rather than an offset, so concurrent inserts and deletes
+// never make a client skip or repeat books. In ID order, the default, a page
+// is read from the store starting after that book; other orders keep only
+// the page being built while going through the catalog. Only the first page
+// counts every matching book, later ones take total_size from the token.
 func (s *LibraryServer) ListBooks(ctx context.Context, req *pb.ListBooksRequest) (*pb.ListBooksResponse, error) {
-	s.store.mu.Lock()
-	defer s.store.mu.Unlock()
+	pageSize, err := resolvePageSize("page_size", req.PageSize, defaultPageSize, maxPageSize)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := parseFilter(req.Filter)
+	if err != nil {
+		return nil, fieldError(codes.InvalidArgument, "filter", err.Error())
+	}
+	order, err := parseOrderBy(req.OrderBy)
+	if err != nil {
+		return nil, fieldError(codes.InvalidArgument, "order_by", err.Error())
+	}
+	fingerprint := queryFingerprint(req.Filter, req.OrderBy, req.BranchId)
+	var token *pageToken
+	if req.PageToken != "" {
+		if token, err = decodePageToken(req.PageToken, fingerprint, order); err != nil {
+			return nil, fieldError(codes.InvalidArgument, "page_token", err.Error())
+		}
+	}
+	match := func(book *pb.Book) bool { return filter(book) && atBranch(book, req.BranchId) }
 
-	var books []*pb.Book
-	for _, book := range s.store.books {
-		books = append(books, book)
+	// Read one book more than the page holds to know whether another follows
+	var page []*pb.Book
+	var total int32
+	if order[0] == (orderField{name: "id"}) {
+		var afterID int32
+		if token != nil {
+			id, _ := strconv.ParseInt(token.Last[0], 10, 32)
+			afterID = int32(id)
+		}
+		err = scanBooks(ctx, s.store, afterID, func(book *pb.Book) bool {
+			if match(book) {
+				total++
+				if len(page) <= pageSize {
+					page = append(page, book)
+				}
+			}
+			return token == nil || len(page) <= pageSize
+		})
+	} else {
+		first := &firstBooks{order: order, n: pageSize + 1}
+		err = scanBooks(ctx, s.store, 0, func(book *pb.Book) bool {
+			if match(book) {
+				total++
+				if token == nil || order.compareKey(book, token.Last) > 0 {
+					first.add(book)
+				}
+			}
+			return true
+		})
+		page = first.sorted()
+	}
+	if err != nil {
+		return nil, toStatus(err, bookResourceType, "books")
+	}
+	if token != nil {
+		total = token.Total
 	}
 
-	return &pb.ListBooksResponse{Books: books}, nil
+	resp := &pb.ListBooksResponse{TotalSize: total}
+	if len(page) > pageSize {
+		page = page[:pageSize]
+		resp.NextPageToken = encodePageToken(pageToken{Query: fingerprint, Last: order.key(page[pageSize-1]), Total: total})
+	}
+	resp.Books = page
+	return resp, nil
+}
+
+// Page sizes for SearchBooks
+const (
+	defaultSearchPageSize = 20
+	maxSearchPageSize     = 100
+)
+
+// SearchBooks implementation
+func (s *LibraryServer) SearchBooks(ctx context.Context, req *pb.SearchBooksRequest) (*pb.SearchBooksResponse, error) {
+	pageSize, err := resolvePageSize("page_size", req.PageSize, defaultSearchPageSize, maxSearchPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	hits := s.index.search(req.Query, req.Exact)
+	resp := &pb.SearchBooksResponse{TotalSize: int32(len(hits))}
+	for _, hit := range hits[:min(pageSize, len(hits))] {
+		resp.Hits = append(resp.Hits, &pb.SearchHit{
+			Book:       proto.Clone(hit.book).(*pb.Book),
+			Score:      hit.score,
+			Highlights: hit.highlights,
+		})
+	}
+	return resp, nil
 }
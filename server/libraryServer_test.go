@@ -0,0 +1,232 @@
+package server
+
+import (
+	"context"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"slices"
+	"testing"
+)
+
+// listBooks walks every page of a ListBooks query and returns the IDs of
+// the books in order, checking total_size on each page
+func listBooks(t *testing.T, s *LibraryServer, req *pb.ListBooksRequest, wantTotal int32) []int32 {
+	t.Helper()
+	var ids []int32
+	for {
+		resp, err := s.ListBooks(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.TotalSize != wantTotal {
+			t.Errorf("total_size %d, want %d", resp.TotalSize, wantTotal)
+		}
+		for _, b := range resp.Books {
+			ids = append(ids, b.Id)
+		}
+		if resp.NextPageToken == "" {
+			return ids
+		}
+		if len(resp.Books) != int(req.PageSize) {
+			t.Errorf("page of %d books before the last, want %d", len(resp.Books), req.PageSize)
+		}
+		req.PageToken = resp.NextPageToken
+	}
+}
+
+// newListServer returns a LibraryServer over books 1 to 7
+func newListServer(t *testing.T) *LibraryServer {
+	t.Helper()
+	store := NewBookStore()
+	for _, b := range []*pb.Book{
+		{Id: 1, Title: "Dune", Author: "Herbert", PublicationYear: 1965},
+		{Id: 2, Title: "emma", Author: "Austen", PublicationYear: 1815},
+		{Id: 3, Title: "Persuasion", Author: "Austen", PublicationYear: 1817},
+		{Id: 4, Title: "Children of Dune", Author: "Herbert", PublicationYear: 1976},
+		{Id: 5, Title: "Dune Messiah", Author: "Herbert", PublicationYear: 1969},
+		{Id: 6, Title: "Emma", Author: "Austen", PublicationYear: 1815, Genre: "Classic"},
+		{Id: 7, Title: "Ulysses", Author: "Joyce", PublicationYear: 1922},
+	} {
+		if err := store.CreateBook(context.Background(), b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s, err := NewLibraryServer(store, DefaultWatchHistory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestListBooksPages(t *testing.T) {
+	s := newListServer(t)
+	tests := []struct {
+		filter, orderBy string
+		want            []int32
+	}{
+		{"", "", []int32{1, 2, 3, 4, 5, 6, 7}},
+		{"", "id desc", []int32{7, 6, 5, 4, 3, 2, 1}},
+		{"", "publication_year desc", []int32{4, 5, 1, 7, 3, 2, 6}},
+		{"", "title", []int32{4, 1, 5, 2, 6, 3, 7}},
+		{"", "title desc", []int32{7, 3, 2, 6, 5, 1, 4}},
+		{"", "author, publication_year desc", []int32{3, 2, 6, 4, 5, 1, 7}},
+		{`author = "Herbert"`, "", []int32{1, 4, 5}},
+		{`author = "Austen"`, "title desc, id desc", []int32{3, 6, 2}},
+		{`title = "Nothing*"`, "", nil},
+	}
+	for _, tc := range tests {
+		for _, size := range []int32{1, 2, 3, 100} {
+			req := &pb.ListBooksRequest{PageSize: size, Filter: tc.filter, OrderBy: tc.orderBy}
+			got := listBooks(t, s, req, int32(len(tc.want)))
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("filter %q order_by %q page size %d: got %v, want %v", tc.filter, tc.orderBy, size, got, tc.want)
+			}
+		}
+	}
+}
+
+func TestListBooksPageToken(t *testing.T) {
+	ctx := context.Background()
+	s := newListServer(t)
+	req := &pb.ListBooksRequest{PageSize: 2, Filter: `author = "Austen"`, OrderBy: "title"}
+	resp, err := s.ListBooks(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The token carries the sort key, not the book
+	if len(resp.NextPageToken) > 100 {
+		t.Errorf("page token of %d bytes: %s", len(resp.NextPageToken), resp.NextPageToken)
+	}
+
+	for _, tc := range []struct {
+		name string
+		req  *pb.ListBooksRequest
+	}{
+		{"other filter", &pb.ListBooksRequest{PageSize: 2, Filter: `author = "Herbert"`, OrderBy: "title"}},
+		{"other order", &pb.ListBooksRequest{PageSize: 2, Filter: `author = "Austen"`, OrderBy: "title desc"}},
+		{"other branch", &pb.ListBooksRequest{PageSize: 2, Filter: `author = "Austen"`, OrderBy: "title", BranchId: 1}},
+	} {
+		tc.req.PageToken = resp.NextPageToken
+		if _, err := s.ListBooks(ctx, tc.req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: got %v, want InvalidArgument", tc.name, err)
+		}
+	}
+	for _, token := range []string{"garbage", "e30"} {
+		req := &pb.ListBooksRequest{PageToken: token}
+		if _, err := s.ListBooks(ctx, req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("token %q: got %v, want InvalidArgument", token, err)
+		}
+	}
+}
+
+func TestListBooksConcurrentChanges(t *testing.T) {
+	ctx := context.Background()
+	for _, orderBy := range []string{"", "title"} {
+		s := newListServer(t)
+		req := &pb.ListBooksRequest{PageSize: 3, OrderBy: orderBy}
+		resp, err := s.ListBooks(ctx, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		seen := map[int32]bool{}
+		for _, b := range resp.Books {
+			seen[b.Id] = true
+		}
+
+		// Delete a book already returned and one still to come, and add one
+		// at the end of both orders
+		returned, pending := resp.Books[0].Id, resp.Books[0].Id
+		for _, id := range []int32{1, 2, 3, 4, 5, 6, 7} {
+			if !seen[id] {
+				pending = id
+			}
+		}
+		for _, id := range []int32{returned, pending} {
+			if err := s.store.DeleteBook(ctx, id); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := s.store.CreateBook(ctx, &pb.Book{Id: 8, Title: "Zazie"}); err != nil {
+			t.Fatal(err)
+		}
+
+		req.PageToken = resp.NextPageToken
+		rest := listBooks(t, s, req, 7)
+		for _, id := range rest {
+			if seen[id] {
+				t.Errorf("order_by %q: book %d returned twice", orderBy, id)
+			}
+			seen[id] = true
+		}
+		for _, id := range []int32{1, 2, 3, 4, 5, 6, 7, 8} {
+			if !seen[id] && id != pending {
+				t.Errorf("order_by %q: book %d skipped", orderBy, id)
+			}
+		}
+		if seen[pending] {
+			t.Errorf("order_by %q: deleted book %d returned", orderBy, pending)
+		}
+	}
+}
+
+func TestStaleRevision(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewLibraryServer(NewBookStore(), DefaultWatchHistory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	created, err := s.CreateBook(ctx, &pb.CreateBookRequest{Book: &pb.Book{Title: "Dune"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := created.Book.Id
+	if created.Book.Revision != 1 {
+		t.Fatalf("created revision %d, want 1", created.Book.Revision)
+	}
+	updated, err := s.UpdateBook(ctx, &pb.UpdateBookRequest{Book: &pb.Book{Id: id, Title: "Dune", Revision: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Book.Revision != 2 {
+		t.Fatalf("updated revision %d, want 2", updated.Book.Revision)
+	}
+
+	stale := []struct {
+		name string
+		call func() error
+	}{
+		{"update", func() error {
+			_, err := s.UpdateBook(ctx, &pb.UpdateBookRequest{Book: &pb.Book{Id: id, Title: "Emma", Revision: 1}})
+			return err
+		}},
+		{"patch", func() error {
+			_, err := s.PatchBook(ctx, &pb.PatchBookRequest{Book: &pb.Book{Id: id, Title: "Emma", Revision: 1}})
+			return err
+		}},
+		{"delete", func() error {
+			_, err := s.DeleteBook(ctx, &pb.DeleteBookRequest{Id: id, Revision: 1})
+			return err
+		}},
+	}
+	for _, tc := range stale {
+		if err := tc.call(); status.Code(err) != codes.Aborted {
+			t.Errorf("%s with a stale revision: got %v, want Aborted", tc.name, err)
+		}
+	}
+	book, err := s.store.GetBook(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if book.Title != "Dune" || book.Revision != 2 {
+		t.Errorf("book after stale writes: %v", book)
+	}
+
+	// The current revision, or none, is accepted
+	if _, err := s.PatchBook(ctx, &pb.PatchBookRequest{Book: &pb.Book{Id: id, Title: "Dune Messiah", Revision: 2}}); err != nil {
+		t.Errorf("patch with the current revision: %v", err)
+	}
+	if _, err := s.DeleteBook(ctx, &pb.DeleteBookRequest{Id: id}); err != nil {
+		t.Errorf("delete without a revision: %v", err)
+	}
+}
@@ -0,0 +1,159 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"strings"
+)
+
+// matches reports whether a loan is selected by the filter
+func (f LoanFilter) matches(loan *pb.Loan) bool {
+	return (f.MemberID == 0 || loan.MemberId == f.MemberID) &&
+		(f.BookID == 0 || loan.BookId == f.BookID) &&
+		(f.CopyID == 0 || loan.CopyId == f.CopyID) &&
+		(!f.OpenOnly || loan.ReturnedAt == nil)
+}
+
+// In-memory implementation of LoanTx
+
+// CreateLoan adds a new loan, failing if its ID is already taken
+func (tx *memTx) CreateLoan(ctx context.Context, loan *pb.Loan) error {
+	if _, exists := tx.s.loans.rows[loan.Id]; exists {
+		return ErrAlreadyExists
+	}
+	loan.Revision = 1
+	tx.s.loans.put(tx, loan)
+	return nil
+}
+
+// GetLoan returns a copy of the loan with the given ID
+func (tx *memTx) GetLoan(ctx context.Context, id int32) (*pb.Loan, error) {
+	return tx.s.loans.get(id)
+}
+
+// UpdateLoan replaces an existing loan
+func (tx *memTx) UpdateLoan(ctx context.Context, loan *pb.Loan) error {
+	prev, exists := tx.s.loans.rows[loan.Id]
+	if !exists {
+		return ErrNotFound
+	}
+	loan.Revision = prev.Revision + 1
+	tx.s.loans.put(tx, loan)
+	return nil
+}
+
+// ListLoans returns a copy of the matching loans ordered by ID
+func (tx *memTx) ListLoans(ctx context.Context, filter LoanFilter) ([]*pb.Loan, error) {
+	var loans []*pb.Loan
+	for _, loan := range tx.s.loans.list() {
+		if filter.matches(loan) {
+			loans = append(loans, loan)
+		}
+	}
+	return loans, nil
+}
+
+// NextLoanID allocates the next unused loan ID
+func (tx *memTx) NextLoanID(ctx context.Context) (int32, error) {
+	return tx.s.loans.nextID(tx)
+}
+
+// SQL implementation of LoanTx
+
+const loanColumns = `id, book_id, copy_id, member_id, checked_out_at, due_at, returned_at, renewals, revision`
+
+// scanLoan reads a row selected with loanColumns
+func scanLoan(row scanner) (*pb.Loan, error) {
+	loan := &pb.Loan{}
+	var checkedOutAt, dueAt, returnedAt sql.NullInt64
+	err := row.Scan(&loan.Id, &loan.BookId, &loan.CopyId, &loan.MemberId,
+		&checkedOutAt, &dueAt, &returnedAt, &loan.Renewals, &loan.Revision)
+	loan.CheckedOutAt = timestampOf(checkedOutAt)
+	loan.DueAt = timestampOf(dueAt)
+	loan.ReturnedAt = timestampOf(returnedAt)
+	return loan, err
+}
+
+// CreateLoan implementation
+func (tx *sqlTx) CreateLoan(ctx context.Context, loan *pb.Loan) error {
+	if _, err := tx.GetLoan(ctx, loan.Id); err == nil {
+		return ErrAlreadyExists
+	} else if !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	if _, err := tx.q.ExecContext(ctx,
+		`INSERT INTO loans (`+loanColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, 1)`,
+		loan.Id, loan.BookId, loan.CopyId, loan.MemberId, unixSeconds(loan.CheckedOutAt),
+		unixSeconds(loan.DueAt), unixSeconds(loan.ReturnedAt), loan.Renewals); err != nil {
+		return err
+	}
+	loan.Revision = 1
+	return tx.bumpSequence(ctx, "loans", loan.Id)
+}
+
+// GetLoan implementation
+func (tx *sqlTx) GetLoan(ctx context.Context, id int32) (*pb.Loan, error) {
+	loan, err := scanLoan(tx.q.QueryRowContext(ctx, `SELECT `+loanColumns+` FROM loans WHERE id = ?`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return loan, err
+}
+
+// UpdateLoan implementation
+func (tx *sqlTx) UpdateLoan(ctx context.Context, loan *pb.Loan) error {
+	err := tx.q.QueryRowContext(ctx,
+		`UPDATE loans SET book_id = ?, copy_id = ?, member_id = ?, checked_out_at = ?, due_at = ?,
+		returned_at = ?, renewals = ?, revision = revision + 1 WHERE id = ? RETURNING revision`,
+		loan.BookId, loan.CopyId, loan.MemberId, unixSeconds(loan.CheckedOutAt), unixSeconds(loan.DueAt),
+		unixSeconds(loan.ReturnedAt), loan.Renewals, loan.Id).Scan(&loan.Revision)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// ListLoans implementation
+func (tx *sqlTx) ListLoans(ctx context.Context, filter LoanFilter) ([]*pb.Loan, error) {
+	var where []string
+	var args []any
+	for _, c := range []struct {
+		column string
+		value  int32
+	}{{"member_id", filter.MemberID}, {"book_id", filter.BookID}, {"copy_id", filter.CopyID}} {
+		if c.value != 0 {
+			where = append(where, c.column+" = ?")
+			args = append(args, c.value)
+		}
+	}
+	if filter.OpenOnly {
+		where = append(where, "returned_at IS NULL")
+	}
+	query := `SELECT ` + loanColumns + ` FROM loans`
+	if len(where) > 0 {
+		query += ` WHERE ` + strings.Join(where, " AND ")
+	}
+
+	rows, err := tx.q.QueryContext(ctx, query+` ORDER BY id`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var loans []*pb.Loan
+	for rows.Next() {
+		loan, err := scanLoan(rows)
+		if err != nil {
+			return nil, err
+		}
+		loans = append(loans, loan)
+	}
+	return loans, rows.Err()
+}
+
+// NextLoanID implementation
+func (tx *sqlTx) NextLoanID(ctx context.Context) (int32, error) {
+	return tx.nextID(ctx, "loans", "loans")
+}
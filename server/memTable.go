@@ -0,0 +1,177 @@
+package server
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"math"
+	"sort"
+)
+
+// record is a stored message identified by an int32 ID
+type record interface {
+	proto.Message
+	GetId() int32
+}
+
+// memTable holds the records of one kind for the in-memory store, along
+// with the sequence that allocates their IDs. Records are cloned on the way
+// in and out so that callers never share them with the store.
+type memTable[T record] struct {
+	kind    byte // Kind of the record mutations
+	seqKind byte // Kind of the sequence mutations
+	rows    map[int32]T
+	last    int32 // Highest ID ever stored or allocated, never decreases
+
+	// uniqueKey, when set, extracts a key that must be unique among the
+	// records that have one; keys maps each key in use to its record ID
+	uniqueKey func(T) string
+	keys      map[string]int32
+}
+
+// newMemTable creates an empty table
+func newMemTable[T record](kind, seqKind byte) *memTable[T] {
+	return &memTable[T]{kind: kind, seqKind: seqKind, rows: make(map[int32]T)}
+}
+
+// withUniqueKey adds a unique index on the non-empty values of key
+func (t *memTable[T]) withUniqueKey(key func(T) string) *memTable[T] {
+	t.uniqueKey = key
+	t.keys = make(map[string]int32)
+	return t
+}
+
+// set stores rec under id, or removes the entry if ok is false, and keeps
+// the unique index in sync
+func (t *memTable[T]) set(id int32, rec T, ok bool) {
+	if t.uniqueKey != nil {
+		if prev, exists := t.rows[id]; exists {
+			if k := t.uniqueKey(prev); k != "" && t.keys[k] == id {
+				delete(t.keys, k)
+			}
+		}
+		if ok {
+			if k := t.uniqueKey(rec); k != "" {
+				t.keys[k] = id
+			}
+		}
+	}
+	if ok {
+		t.rows[id] = rec
+	} else {
+		delete(t.rows, id)
+	}
+}
+
+// lookup returns the ID of the record with the given unique key
+func (t *memTable[T]) lookup(key string) (int32, bool) {
+	id, ok := t.keys[key]
+	return id, ok && key != ""
+}
+
+// checkUnique fails with dup if another record uses the unique key of rec
+func (t *memTable[T]) checkUnique(rec T, dup error) error {
+	if id, ok := t.lookup(t.uniqueKey(rec)); ok && id != rec.GetId() {
+		return dup
+	}
+	return nil
+}
+
+// get returns a copy of the record with the given ID
+func (t *memTable[T]) get(id int32) (T, error) {
+	rec, ok := t.rows[id]
+	if !ok {
+		var zero T
+		return zero, ErrNotFound
+	}
+	return proto.Clone(rec).(T), nil
+}
+
+// list returns a copy of every record ordered by ID
+func (t *memTable[T]) list() []T {
+	recs := make([]T, 0, len(t.rows))
+	for _, rec := range t.rows {
+		recs = append(recs, proto.Clone(rec).(T))
+	}
+	sort.Slice(recs, func(i, j int) bool { return recs[i].GetId() < recs[j].GetId() })
+	return recs
+}
+
+// put stores a copy of rec within tx
+func (t *memTable[T]) put(tx *memTx, rec T) {
+	rec = proto.Clone(rec).(T)
+	id := rec.GetId()
+	prev, existed := t.rows[id]
+	t.set(id, rec, true)
+	tx.muts = append(tx.muts, mutation{op: opPut, kind: t.kind, msg: rec})
+	tx.undo = append(tx.undo, func() { t.set(id, prev, existed) })
+	if id > t.last {
+		t.setLast(tx, id)
+	}
+}
+
+// delete removes the record with the given ID within tx
+func (t *memTable[T]) delete(tx *memTx, id int32) error {
+	prev, ok := t.rows[id]
+	if !ok {
+		return ErrNotFound
+	}
+	var zero T
+	t.set(id, zero, false)
+	tx.muts = append(tx.muts, mutation{op: opDelete, kind: t.kind, msg: prev})
+	tx.undo = append(tx.undo, func() { t.set(id, prev, true) })
+	return nil
+}
+
+// nextID allocates an ID greater than any stored so far within tx
+func (t *memTable[T]) nextID(tx *memTx) (int32, error) {
+	if t.last == math.MaxInt32 {
+		return 0, ErrIDsExhausted
+	}
+	id := t.last + 1
+	t.setLast(tx, id)
+	tx.muts = append(tx.muts, mutation{op: opPut, kind: t.seqKind, msg: wrapperspb.Int32(id)})
+	return id, nil
+}
+
+// setLast moves the sequence and records how to restore it
+func (t *memTable[T]) setLast(tx *memTx, id int32) {
+	prev := t.last
+	t.last = id
+	tx.undo = append(tx.undo, func() { t.last = prev })
+}
+
+// apply replays a mutation of this table and reports whether it was one
+func (t *memTable[T]) apply(m mutation) bool {
+	switch m.kind {
+	case t.kind:
+		rec := m.msg.(T)
+		if m.op == opDelete {
+			t.set(rec.GetId(), rec, false)
+		} else {
+			t.set(rec.GetId(), rec, true)
+			t.last = max(t.last, rec.GetId())
+		}
+	case t.seqKind:
+		t.last = max(t.last, m.msg.(*wrapperspb.Int32Value).Value)
+	default:
+		return false
+	}
+	return true
+}
+
+// snapshot returns the content of the table as put mutations
+func (t *memTable[T]) snapshot() []mutation {
+	muts := make([]mutation, 0, len(t.rows)+1)
+	muts = append(muts, mutation{op: opPut, kind: t.seqKind, msg: wrapperspb.Int32(t.last)})
+	for _, rec := range t.rows {
+		muts = append(muts, mutation{op: opPut, kind: t.kind, msg: rec})
+	}
+	return muts
+}
+
+// memTableOps is the part of memTable that does not depend on its record
+// type, used to replay and snapshot every table alike
+type memTableOps interface {
+	apply(m mutation) bool
+	snapshot() []mutation
+}
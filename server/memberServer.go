@@ -0,0 +1,298 @@
+package server
+
+import (
+	"context"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"log"
+	"net/mail"
+	"sort"
+	"strings"
+)
+
+// MemberServer is used to implement the MemberService
+type MemberServer struct {
+	pb.UnimplementedMemberServiceServer
+	store Store
+}
+
+// NewMemberServer creates a MemberServer backed by the given Store
+func NewMemberServer(store Store) *MemberServer {
+	return &MemberServer{store: store}
+}
+
+// prepareMember validates a member sent by a client and returns a
+// normalized copy. Unset tier and status default to STANDARD and ACTIVE.
+func prepareMember(member *pb.Member) (*pb.Member, error) {
+	if member == nil {
+		return nil, fieldError(codes.InvalidArgument, "member", "member is required")
+	}
+	member = proto.Clone(member).(*pb.Member)
+
+	member.Name = strings.TrimSpace(member.Name)
+	if member.Name == "" {
+		return nil, fieldError(codes.InvalidArgument, "member.name", "name is required")
+	}
+	if member.Email = strings.TrimSpace(member.Email); member.Email != "" {
+		addr, err := mail.ParseAddress(member.Email)
+		if err != nil || addr.Address != member.Email {
+			return nil, fieldError(codes.InvalidArgument, "member.email", "not a valid email address")
+		}
+		member.Email = strings.ToLower(member.Email)
+	}
+	member.Phone = strings.TrimSpace(member.Phone)
+
+	if _, ok := pb.Member_Tier_name[int32(member.Tier)]; !ok {
+		return nil, fieldError(codes.InvalidArgument, "member.tier", "unknown tier")
+	}
+	if member.Tier == pb.Member_TIER_UNSPECIFIED {
+		member.Tier = pb.Member_STANDARD
+	}
+	if _, ok := pb.Member_Status_name[int32(member.Status)]; !ok {
+		return nil, fieldError(codes.InvalidArgument, "member.status", "unknown status")
+	}
+	if member.Status == pb.Member_STATUS_UNSPECIFIED {
+		member.Status = pb.Member_ACTIVE
+	}
+	if member.ExpiresAt != nil {
+		if err := member.ExpiresAt.CheckValid(); err != nil {
+			return nil, fieldError(codes.InvalidArgument, "member.expires_at", err.Error())
+		}
+		member.ExpiresAt.Nanos = 0
+	}
+	return member, nil
+}
+
+// checkMemberRevision fails with ErrRevisionMismatch unless expected is
+// zero or the current revision of the member
+func checkMemberRevision(ctx context.Context, tx Tx, id int32, expected int64) error {
+	if expected == 0 {
+		return nil
+	}
+	current, err := tx.GetMember(ctx, id)
+	if err != nil {
+		return err
+	}
+	if current.Revision != expected {
+		return ErrRevisionMismatch
+	}
+	return nil
+}
+
+// CreateMember implementation. The server assigns the next ID when the
+// request leaves it empty.
+func (s *MemberServer) CreateMember(ctx context.Context, req *pb.CreateMemberRequest) (*pb.CreateMemberResponse, error) {
+	member, err := prepareMember(req.Member)
+	if err != nil {
+		return nil, err
+	}
+	err = s.store.RunInTx(ctx, func(tx Tx) error {
+		if member.Id == 0 {
+			id, err := tx.NextMemberID(ctx)
+			if err != nil {
+				return err
+			}
+			member.Id = id
+		}
+		return tx.CreateMember(ctx, member)
+	})
+	if err != nil {
+		return nil, memberError(err, member.Id)
+	}
+	log.Printf("Member added: %d", member.Id)
+
+	return &pb.CreateMemberResponse{Member: member}, nil
+}
+
+// GetMember implementation
+func (s *MemberServer) GetMember(ctx context.Context, req *pb.GetMemberRequest) (*pb.GetMemberResponse, error) {
+	var member *pb.Member
+	err := s.store.RunInTx(ctx, func(tx Tx) (err error) {
+		member, err = tx.GetMember(ctx, req.Id)
+		return err
+	})
+	if err != nil {
+		return nil, memberError(err, req.Id)
+	}
+
+	return &pb.GetMemberResponse{Member: member}, nil
+}
+
+// UpdateMember implementation
+func (s *MemberServer) UpdateMember(ctx context.Context, req *pb.UpdateMemberRequest) (*pb.UpdateMemberResponse, error) {
+	member, err := prepareMember(req.Member)
+	if err != nil {
+		return nil, err
+	}
+	err = s.store.RunInTx(ctx, func(tx Tx) error {
+		if err := checkMemberRevision(ctx, tx, member.Id, member.Revision); err != nil {
+			return err
+		}
+		return tx.UpdateMember(ctx, member)
+	})
+	if err != nil {
+		return nil, memberError(err, member.Id)
+	}
+	log.Printf("Member updated: %d", member.Id)
+
+	return &pb.UpdateMemberResponse{Member: member}, nil
+}
+
+// DeleteMember implementation
+func (s *MemberServer) DeleteMember(ctx context.Context, req *pb.DeleteMemberRequest) (*pb.DeleteMemberResponse, error) {
+	err := s.store.RunInTx(ctx, func(tx Tx) error {
+		if err := checkMemberRevision(ctx, tx, req.Id, req.Revision); err != nil {
+			return err
+		}
+		loans, err := tx.ListLoans(ctx, LoanFilter{MemberID: req.Id, OpenOnly: true})
+		if err != nil {
+			return err
+		}
+		if len(loans) > 0 {
+			return preconditionError("OPEN_LOANS", memberName(req.Id), "member still has books on loan")
+		}
+		holds, err := tx.ListHolds(ctx, HoldFilter{MemberID: req.Id, ActiveOnly: true})
+		if err != nil {
+			return err
+		}
+		if len(holds) > 0 {
+			return preconditionError("ACTIVE_HOLDS", memberName(req.Id), "member still has holds; cancel them first")
+		}
+		balance, err := balanceOf(ctx, tx, req.Id)
+		if err != nil {
+			return err
+		}
+		if balance != 0 {
+			return preconditionError("OUTSTANDING_BALANCE", memberName(req.Id), "member has a fee balance to settle")
+		}
+		return tx.DeleteMember(ctx, req.Id)
+	})
+	if err != nil {
+		return nil, memberError(err, req.Id)
+	}
+	log.Printf("Member deleted: %d", req.Id)
+
+	return &pb.DeleteMemberResponse{Success: true}, nil
+}
+
+// listMembers returns every stored member ordered by ID
+func (s *MemberServer) listMembers(ctx context.Context) ([]*pb.Member, error) {
+	var members []*pb.Member
+	err := s.store.RunInTx(ctx, func(tx Tx) (err error) {
+		members, err = tx.ListMembers(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, toStatus(err, memberResourceType, "members")
+	}
+	return members, nil
+}
+
+// ListMembers implementation
+func (s *MemberServer) ListMembers(ctx context.Context, req *pb.ListMembersRequest) (*pb.ListMembersResponse, error) {
+	pageSize, err := resolvePageSize("page_size", req.PageSize, defaultPageSize, maxPageSize)
+	if err != nil {
+		return nil, err
+	}
+	var after int32
+	if req.PageToken != "" {
+		if after, err = decodeIDPageToken(req.PageToken); err != nil {
+			return nil, fieldError(codes.InvalidArgument, "page_token", err.Error())
+		}
+	}
+
+	members, err := s.listMembers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := members[:0]
+	for _, m := range members {
+		if (req.Tier == pb.Member_TIER_UNSPECIFIED || m.Tier == req.Tier) &&
+			(req.Status == pb.Member_STATUS_UNSPECIFIED || m.Status == req.Status) {
+			matched = append(matched, m)
+		}
+	}
+	start := sort.Search(len(matched), func(i int) bool { return matched[i].Id > after })
+	end := min(start+pageSize, len(matched))
+
+	resp := &pb.ListMembersResponse{
+		Members:   matched[start:end],
+		TotalSize: int32(len(matched)),
+	}
+	if end < len(matched) {
+		resp.NextPageToken = encodeIDPageToken(matched[end-1].Id)
+	}
+	return resp, nil
+}
+
+// SearchMembers implementation. Every word of the query must start a word
+// of the name or email, or, if it has at least three digits, appear in the
+// digits of the phone number.
+func (s *MemberServer) SearchMembers(ctx context.Context, req *pb.SearchMembersRequest) (*pb.SearchMembersResponse, error) {
+	pageSize, err := resolvePageSize("page_size", req.PageSize, defaultSearchPageSize, maxSearchPageSize)
+	if err != nil {
+		return nil, err
+	}
+	words := strings.Fields(strings.ToLower(req.Query))
+	if len(words) == 0 {
+		return nil, fieldError(codes.InvalidArgument, "query", "query is required")
+	}
+
+	members, err := s.listMembers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []*pb.Member
+	for _, m := range members {
+		if matchesMember(m, words) {
+			matched = append(matched, m)
+		}
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		return strings.ToLower(matched[i].Name) < strings.ToLower(matched[j].Name)
+	})
+
+	return &pb.SearchMembersResponse{
+		Members:   matched[:min(pageSize, len(matched))],
+		TotalSize: int32(len(matched)),
+	}, nil
+}
+
+// matchesMember reports whether every query word matches the member
+func matchesMember(m *pb.Member, words []string) bool {
+	tokens := append(tokenize(m.Name), tokenize(m.Email)...)
+	phone := digitsOf(m.Phone)
+	for _, w := range words {
+		found := false
+		for _, t := range tokens {
+			if strings.HasPrefix(t.term, w) {
+				found = true
+				break
+			}
+		}
+		if d := digitsOf(w); !found && len(d) >= 3 && strings.Contains(phone, d) {
+			found = true
+		}
+		if !found && strings.Contains(w, "@") && w == m.Email {
+			found = true
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// digitsOf returns the decimal digits of s
+func digitsOf(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,137 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+)
+
+// In-memory implementation of MemberTx
+
+// CreateMember adds a new member, failing if its ID is already taken
+func (tx *memTx) CreateMember(ctx context.Context, member *pb.Member) error {
+	if _, exists := tx.s.members.rows[member.Id]; exists {
+		return ErrAlreadyExists
+	}
+	member.Revision = 1
+	tx.s.members.put(tx, member)
+	return nil
+}
+
+// GetMember returns a copy of the member with the given ID
+func (tx *memTx) GetMember(ctx context.Context, id int32) (*pb.Member, error) {
+	return tx.s.members.get(id)
+}
+
+// UpdateMember replaces an existing member
+func (tx *memTx) UpdateMember(ctx context.Context, member *pb.Member) error {
+	prev, exists := tx.s.members.rows[member.Id]
+	if !exists {
+		return ErrNotFound
+	}
+	member.Revision = prev.Revision + 1
+	tx.s.members.put(tx, member)
+	return nil
+}
+
+// DeleteMember removes the member with the given ID
+func (tx *memTx) DeleteMember(ctx context.Context, id int32) error {
+	return tx.s.members.delete(tx, id)
+}
+
+// ListMembers returns a copy of every member ordered by ID
+func (tx *memTx) ListMembers(ctx context.Context) ([]*pb.Member, error) {
+	return tx.s.members.list(), nil
+}
+
+// NextMemberID allocates the next unused member ID
+func (tx *memTx) NextMemberID(ctx context.Context) (int32, error) {
+	return tx.s.members.nextID(tx)
+}
+
+// SQL implementation of MemberTx
+
+const memberColumns = `id, name, email, phone, address, tier, status, expires_at, revision`
+
+// scanMember reads a row selected with memberColumns
+func scanMember(row scanner) (*pb.Member, error) {
+	member := &pb.Member{}
+	var expiresAt sql.NullInt64
+	err := row.Scan(&member.Id, &member.Name, &member.Email, &member.Phone, &member.Address,
+		&member.Tier, &member.Status, &expiresAt, &member.Revision)
+	member.ExpiresAt = timestampOf(expiresAt)
+	return member, err
+}
+
+// CreateMember implementation
+func (tx *sqlTx) CreateMember(ctx context.Context, member *pb.Member) error {
+	if _, err := tx.GetMember(ctx, member.Id); err == nil {
+		return ErrAlreadyExists
+	} else if !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	if _, err := tx.q.ExecContext(ctx,
+		`INSERT INTO members (`+memberColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, 1)`,
+		member.Id, member.Name, member.Email, member.Phone, member.Address,
+		member.Tier, member.Status, unixSeconds(member.ExpiresAt)); err != nil {
+		return err
+	}
+	member.Revision = 1
+	return tx.bumpSequence(ctx, "members", member.Id)
+}
+
+// GetMember implementation
+func (tx *sqlTx) GetMember(ctx context.Context, id int32) (*pb.Member, error) {
+	member, err := scanMember(tx.q.QueryRowContext(ctx, `SELECT `+memberColumns+` FROM members WHERE id = ?`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return member, err
+}
+
+// UpdateMember implementation
+func (tx *sqlTx) UpdateMember(ctx context.Context, member *pb.Member) error {
+	err := tx.q.QueryRowContext(ctx,
+		`UPDATE members SET name = ?, email = ?, phone = ?, address = ?, tier = ?, status = ?, expires_at = ?,
+		revision = revision + 1 WHERE id = ? RETURNING revision`,
+		member.Name, member.Email, member.Phone, member.Address, member.Tier, member.Status,
+		unixSeconds(member.ExpiresAt), member.Id).Scan(&member.Revision)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// DeleteMember implementation
+func (tx *sqlTx) DeleteMember(ctx context.Context, id int32) error {
+	res, err := tx.q.ExecContext(ctx, `DELETE FROM members WHERE id = ?`, id)
+	if err != nil {
+		return err
+	}
+	return checkAffected(res)
+}
+
+// ListMembers implementation
+func (tx *sqlTx) ListMembers(ctx context.Context) ([]*pb.Member, error) {
+	rows, err := tx.q.QueryContext(ctx, `SELECT `+memberColumns+` FROM members ORDER BY id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var members []*pb.Member
+	for rows.Next() {
+		member, err := scanMember(rows)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, member)
+	}
+	return members, rows.Err()
+}
+
+// NextMemberID implementation
+func (tx *sqlTx) NextMemberID(ctx context.Context) (int32, error) {
+	return tx.nextID(ctx, "members", "members")
+}
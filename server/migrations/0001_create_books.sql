@@ -0,0 +1,12 @@
+-- Books catalog
+CREATE TABLE books (
+    id               INTEGER PRIMARY KEY,
+    title            TEXT    NOT NULL DEFAULT '',
+    author           TEXT    NOT NULL DEFAULT '',
+    isbn             TEXT    NOT NULL DEFAULT '',
+    publication_year INTEGER NOT NULL DEFAULT 0,
+    genre            TEXT    NOT NULL DEFAULT ''
+);
+
+CREATE INDEX books_author ON books (author);
+CREATE INDEX books_genre ON books (genre);
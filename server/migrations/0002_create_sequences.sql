@@ -0,0 +1,7 @@
+-- Monotonic ID sequences, never reset by deletions
+CREATE TABLE sequences (
+    name  TEXT    PRIMARY KEY,
+    value INTEGER NOT NULL
+);
+
+INSERT INTO sequences (name, value) SELECT 'books', COALESCE(MAX(id), 0) FROM books;
@@ -0,0 +1,6 @@
+-- ISBNs are unique. Books stored before ISBN validation may share one; all
+-- but the oldest of them lose it so that the index can be built.
+UPDATE books SET isbn = ''
+WHERE isbn <> '' AND id NOT IN (SELECT MIN(id) FROM books WHERE isbn <> '' GROUP BY isbn);
+
+CREATE UNIQUE INDEX books_isbn ON books (isbn) WHERE isbn <> '';
@@ -0,0 +1,2 @@
+-- Revision of each book, incremented on every change for optimistic concurrency
+ALTER TABLE books ADD COLUMN revision INTEGER NOT NULL DEFAULT 1;
@@ -0,0 +1,16 @@
+-- Library members
+CREATE TABLE members (
+    id         INTEGER PRIMARY KEY,
+    name       TEXT    NOT NULL DEFAULT '',
+    email      TEXT    NOT NULL DEFAULT '',
+    phone      TEXT    NOT NULL DEFAULT '',
+    address    TEXT    NOT NULL DEFAULT '',
+    tier       INTEGER NOT NULL DEFAULT 0,
+    status     INTEGER NOT NULL DEFAULT 0,
+    expires_at INTEGER,
+    revision   INTEGER NOT NULL DEFAULT 1
+);
+
+CREATE INDEX members_name ON members (name);
+
+INSERT INTO sequences (name, value) VALUES ('members', 0);
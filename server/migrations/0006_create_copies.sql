@@ -0,0 +1,16 @@
+-- Physical copies of books
+CREATE TABLE copies (
+    id        INTEGER PRIMARY KEY,
+    book_id   INTEGER NOT NULL,
+    barcode   TEXT    NOT NULL,
+    branch    TEXT    NOT NULL DEFAULT '',
+    shelf     TEXT    NOT NULL DEFAULT '',
+    condition INTEGER NOT NULL DEFAULT 0,
+    status    INTEGER NOT NULL DEFAULT 0,
+    revision  INTEGER NOT NULL DEFAULT 1
+);
+
+CREATE UNIQUE INDEX copies_barcode ON copies (barcode);
+CREATE INDEX copies_book ON copies (book_id);
+
+INSERT INTO sequences (name, value) VALUES ('copies', 0);
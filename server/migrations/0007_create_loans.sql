@@ -0,0 +1,19 @@
+-- Loans of copies to members. Times are Unix seconds.
+CREATE TABLE loans (
+    id             INTEGER PRIMARY KEY,
+    book_id        INTEGER NOT NULL,
+    copy_id        INTEGER NOT NULL,
+    member_id      INTEGER NOT NULL,
+    checked_out_at INTEGER NOT NULL,
+    due_at         INTEGER NOT NULL,
+    returned_at    INTEGER,
+    renewals       INTEGER NOT NULL DEFAULT 0,
+    revision       INTEGER NOT NULL DEFAULT 1
+);
+
+-- A copy can only be on one open loan
+CREATE UNIQUE INDEX loans_open_copy ON loans (copy_id) WHERE returned_at IS NULL;
+CREATE INDEX loans_member ON loans (member_id);
+CREATE INDEX loans_book ON loans (book_id);
+
+INSERT INTO sequences (name, value) VALUES ('loans', 0);
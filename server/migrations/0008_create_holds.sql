@@ -0,0 +1,18 @@
+-- Holds placed by members on books. Times are Unix seconds.
+CREATE TABLE holds (
+    id         INTEGER PRIMARY KEY,
+    book_id    INTEGER NOT NULL,
+    member_id  INTEGER NOT NULL,
+    status     INTEGER NOT NULL DEFAULT 0,
+    placed_at  INTEGER NOT NULL,
+    copy_id    INTEGER NOT NULL DEFAULT 0,
+    ready_at   INTEGER,
+    expires_at INTEGER,
+    closed_at  INTEGER,
+    revision   INTEGER NOT NULL DEFAULT 1
+);
+
+CREATE INDEX holds_book ON holds (book_id, status);
+CREATE INDEX holds_member ON holds (member_id);
+
+INSERT INTO sequences (name, value) VALUES ('holds', 0);
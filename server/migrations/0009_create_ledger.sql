@@ -0,0 +1,20 @@
+-- Fee ledger of members. Amounts are positive minor currency units, times
+-- are Unix seconds. Entries are never updated.
+CREATE TABLE ledger (
+    id         INTEGER PRIMARY KEY,
+    member_id  INTEGER NOT NULL,
+    type       INTEGER NOT NULL,
+    reason     INTEGER NOT NULL DEFAULT 0,
+    amount     INTEGER NOT NULL CHECK (amount > 0),
+    book_id    INTEGER NOT NULL DEFAULT 0,
+    loan_id    INTEGER NOT NULL DEFAULT 0,
+    related_id INTEGER NOT NULL DEFAULT 0,
+    note       TEXT NOT NULL DEFAULT '',
+    created_at INTEGER NOT NULL
+);
+
+CREATE INDEX ledger_member ON ledger (member_id);
+CREATE INDEX ledger_loan ON ledger (loan_id) WHERE loan_id <> 0;
+CREATE INDEX ledger_related ON ledger (related_id) WHERE related_id <> 0;
+
+INSERT INTO sequences (name, value) VALUES ('ledger', 0);
@@ -0,0 +1,23 @@
+-- Authors and their links to books. Aliases are newline-separated.
+CREATE TABLE authors (
+    id       INTEGER PRIMARY KEY,
+    name     TEXT    NOT NULL DEFAULT '',
+    aliases  TEXT    NOT NULL DEFAULT '',
+    bio      TEXT    NOT NULL DEFAULT '',
+    revision INTEGER NOT NULL DEFAULT 1
+);
+
+CREATE INDEX authors_name ON authors (name);
+
+-- Book.authors, in credit order
+CREATE TABLE book_authors (
+    book_id   INTEGER NOT NULL,
+    position  INTEGER NOT NULL,
+    author_id INTEGER NOT NULL,
+    role      INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (book_id, position)
+);
+
+CREATE INDEX book_authors_author ON book_authors (author_id);
+
+INSERT INTO sequences (name, value) VALUES ('authors', 0);
@@ -0,0 +1,36 @@
+-- Library branches. Opening hours are newline-separated "day opens closes"
+-- lines, e.g. "1 09:00 17:00".
+CREATE TABLE branches (
+    id       INTEGER PRIMARY KEY,
+    code     TEXT    NOT NULL UNIQUE,
+    name     TEXT    NOT NULL DEFAULT '',
+    address  TEXT    NOT NULL DEFAULT '',
+    hours    TEXT    NOT NULL DEFAULT '',
+    revision INTEGER NOT NULL DEFAULT 1
+);
+
+-- Book.branch_ids; a book without rows is shared across all branches
+CREATE TABLE book_branches (
+    book_id   INTEGER NOT NULL,
+    branch_id INTEGER NOT NULL,
+    PRIMARY KEY (book_id, branch_id)
+);
+
+CREATE INDEX book_branches_branch ON book_branches (branch_id);
+
+-- Audit records of books moved between branches. Copy IDs are
+-- comma-separated, times are Unix seconds. Records are never updated.
+CREATE TABLE transfers (
+    id             INTEGER PRIMARY KEY,
+    book_id        INTEGER NOT NULL,
+    from_branch_id INTEGER NOT NULL,
+    to_branch_id   INTEGER NOT NULL,
+    copy_ids       TEXT    NOT NULL DEFAULT '',
+    note           TEXT    NOT NULL DEFAULT '',
+    transferred_at INTEGER NOT NULL
+);
+
+CREATE INDEX transfers_book ON transfers (book_id);
+
+INSERT INTO sequences (name, value) VALUES ('branches', 0);
+INSERT INTO sequences (name, value) VALUES ('transfers', 0);
@@ -0,0 +1,41 @@
+-- Copies refer to their branch by ID rather than by code. A code no branch
+-- has, kept from before branches were set up, gets a branch of its own
+-- named after it, with the code itself if it is a valid code and otherwise
+-- branch-<id>, suffixed with -2, -3 and so on until no branch has it.
+CREATE TEMP TABLE legacy_branches AS
+SELECT branch AS code,
+       (SELECT value FROM sequences WHERE name = 'branches') + ROW_NUMBER() OVER (ORDER BY branch) AS id
+FROM (SELECT DISTINCT branch FROM copies
+      WHERE branch <> '' AND branch NOT IN (SELECT code FROM branches));
+
+INSERT INTO branches (id, code, name)
+SELECT id, code, code FROM legacy_branches
+WHERE code NOT GLOB '*[^a-z0-9-]*';
+
+WITH RECURSIVE candidates (id, n, code) AS (
+    SELECT id, 1, 'branch-' || id FROM legacy_branches
+    WHERE code GLOB '*[^a-z0-9-]*'
+    UNION ALL
+    SELECT id, n + 1, 'branch-' || id || '-' || (n + 1) FROM candidates
+    WHERE code IN (SELECT code FROM branches)
+)
+INSERT INTO branches (id, code, name)
+SELECT candidates.id, candidates.code, legacy_branches.code
+FROM candidates JOIN legacy_branches ON legacy_branches.id = candidates.id
+WHERE candidates.code NOT IN (SELECT code FROM branches);
+
+UPDATE sequences SET value = (SELECT MAX(id) FROM legacy_branches)
+WHERE name = 'branches' AND EXISTS (SELECT 1 FROM legacy_branches);
+
+ALTER TABLE copies ADD COLUMN branch_id INTEGER NOT NULL DEFAULT 0;
+
+UPDATE copies SET branch_id = COALESCE(
+    (SELECT id FROM legacy_branches WHERE code = copies.branch),
+    (SELECT id FROM branches WHERE code = copies.branch),
+    0);
+
+DROP TABLE legacy_branches;
+
+ALTER TABLE copies DROP COLUMN branch;
+
+CREATE INDEX copies_branch ON copies (branch_id);
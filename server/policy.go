@@ -0,0 +1,361 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Permissions checked by the Authorizer, named after the resource and the
+// action
+const (
+	PermBooksRead      = "books.read"
+	PermBooksWrite     = "books.write"
+	PermBooksDelete    = "books.delete"
+	PermBooksTransfer  = "books.transfer"
+	PermAuthorsRead    = "authors.read"
+	PermAuthorsWrite   = "authors.write"
+	PermAuthorsDelete  = "authors.delete"
+	PermBranchesRead   = "branches.read"
+	PermBranchesWrite  = "branches.write"
+	PermBranchesDelete = "branches.delete"
+	PermCopiesRead     = "copies.read"
+	PermCopiesWrite    = "copies.write"
+	PermMembersRead    = "members.read"
+	PermMembersWrite   = "members.write"
+	PermMembersDelete  = "members.delete"
+	PermLoansRead      = "loans.read"
+	PermLoansWrite     = "loans.write"
+	PermHoldsRead      = "holds.read"
+	PermHoldsReadOwn   = "holds.read_own"
+	PermHoldsWrite     = "holds.write"
+	PermHoldsWriteOwn  = "holds.write_own"
+	PermHoldsFulfill   = "holds.fulfill"
+	PermFeesRead       = "fees.read"
+	PermFeesCharge     = "fees.charge"
+	PermFeesPay        = "fees.pay"
+	PermFeesWaive      = "fees.waive"
+)
+
+// methodPermissions is the permission required by each RPC. Methods missing
+// here are denied to everyone.
+var methodPermissions = map[string]string{
+	pb.LibraryService_GetBook_FullMethodName:          PermBooksRead,
+	pb.LibraryService_ListBooks_FullMethodName:        PermBooksRead,
+	pb.LibraryService_StreamBooks_FullMethodName:      PermBooksRead,
+	pb.LibraryService_WatchBooks_FullMethodName:       PermBooksRead,
+	pb.LibraryService_BatchGetBooks_FullMethodName:    PermBooksRead,
+	pb.LibraryService_SearchBooks_FullMethodName:      PermBooksRead,
+	pb.LibraryService_CreateBook_FullMethodName:       PermBooksWrite,
+	pb.LibraryService_UpdateBook_FullMethodName:       PermBooksWrite,
+	pb.LibraryService_PatchBook_FullMethodName:        PermBooksWrite,
+	pb.LibraryService_ImportBooks_FullMethodName:      PermBooksWrite,
+	pb.LibraryService_BatchCreateBooks_FullMethodName: PermBooksWrite,
+	pb.LibraryService_BatchUpdateBooks_FullMethodName: PermBooksWrite,
+	pb.LibraryService_DeleteBook_FullMethodName:       PermBooksDelete,
+	pb.LibraryService_BatchDeleteBooks_FullMethodName: PermBooksDelete,
+
+	pb.AuthorService_GetAuthor_FullMethodName:          PermAuthorsRead,
+	pb.AuthorService_ListAuthors_FullMethodName:        PermAuthorsRead,
+	pb.AuthorService_ListAuthorBooks_FullMethodName:    PermAuthorsRead,
+	pb.AuthorService_CreateAuthor_FullMethodName:       PermAuthorsWrite,
+	pb.AuthorService_UpdateAuthor_FullMethodName:       PermAuthorsWrite,
+	pb.AuthorService_DeduplicateAuthors_FullMethodName: PermAuthorsWrite,
+	pb.AuthorService_MergeAuthors_FullMethodName:       PermAuthorsDelete,
+	pb.AuthorService_DeleteAuthor_FullMethodName:       PermAuthorsDelete,
+
+	pb.BranchService_GetBranch_FullMethodName:     PermBranchesRead,
+	pb.BranchService_ListBranches_FullMethodName:  PermBranchesRead,
+	pb.BranchService_ListTransfers_FullMethodName: PermBranchesRead,
+	pb.BranchService_CreateBranch_FullMethodName:  PermBranchesWrite,
+	pb.BranchService_UpdateBranch_FullMethodName:  PermBranchesWrite,
+	pb.BranchService_DeleteBranch_FullMethodName:  PermBranchesDelete,
+	pb.BranchService_TransferBook_FullMethodName:  PermBooksTransfer,
+
+	pb.CopyService_GetCopy_FullMethodName:    PermCopiesRead,
+	pb.CopyService_ListCopies_FullMethodName: PermCopiesRead,
+	pb.CopyService_AddCopy_FullMethodName:    PermCopiesWrite,
+	pb.CopyService_MoveCopy_FullMethodName:   PermCopiesWrite,
+	pb.CopyService_RetireCopy_FullMethodName: PermCopiesWrite,
+
+	pb.MemberService_GetMember_FullMethodName:     PermMembersRead,
+	pb.MemberService_ListMembers_FullMethodName:   PermMembersRead,
+	pb.MemberService_SearchMembers_FullMethodName: PermMembersRead,
+	pb.MemberService_CreateMember_FullMethodName:  PermMembersWrite,
+	pb.MemberService_UpdateMember_FullMethodName:  PermMembersWrite,
+	pb.MemberService_DeleteMember_FullMethodName:  PermMembersDelete,
+
+	pb.CirculationService_ListLoans_FullMethodName:    PermLoansRead,
+	pb.CirculationService_CheckoutBook_FullMethodName: PermLoansWrite,
+	pb.CirculationService_ReturnBook_FullMethodName:   PermLoansWrite,
+	pb.CirculationService_RenewLoan_FullMethodName:    PermLoansWrite,
+
+	pb.HoldService_ListHolds_FullMethodName:       PermHoldsRead,
+	pb.HoldService_PlaceHold_FullMethodName:       PermHoldsWrite,
+	pb.HoldService_CancelHold_FullMethodName:      PermHoldsWrite,
+	pb.HoldService_FulfillNextHold_FullMethodName: PermHoldsFulfill,
+
+	pb.FeeService_GetBalance_FullMethodName:        PermFeesRead,
+	pb.FeeService_ListLedgerEntries_FullMethodName: PermFeesRead,
+	pb.FeeService_ChargeOverdue_FullMethodName:     PermFeesCharge,
+	pb.FeeService_ChargeLostItem_FullMethodName:    PermFeesCharge,
+	pb.FeeService_ChargeFee_FullMethodName:         PermFeesCharge,
+	pb.FeeService_RecordPayment_FullMethodName:     PermFeesPay,
+	pb.FeeService_WaiveCharge_FullMethodName:       PermFeesWaive,
+	pb.FeeService_RefundPayment_FullMethodName:     PermFeesWaive,
+}
+
+// ownMethodPermissions is the permission that allows calling a method on
+// behalf of the member linked to the caller only, as named by the member_id
+// of the request, to callers lacking the permission in methodPermissions
+var ownMethodPermissions = map[string]string{
+	pb.HoldService_ListHolds_FullMethodName:  PermHoldsReadOwn,
+	pb.HoldService_PlaceHold_FullMethodName:  PermHoldsWriteOwn,
+	pb.HoldService_CancelHold_FullMethodName: PermHoldsWriteOwn,
+}
+
+// memberRequest is implemented by requests made on behalf of a member
+type memberRequest interface {
+	GetMemberId() int32
+}
+
+// Policy grants permissions to roles. It is read from a JSON file such as
+//
+//	{"roles": {
+//	  "patron":    {"permissions": ["books.read", "authors.read"]},
+//	  "librarian": {"inherits": ["patron"], "permissions": ["books.write"]},
+//	  "admin":     {"permissions": ["*"]}
+//	}}
+//
+// A permission ending in "*" grants every permission with that prefix.
+type Policy struct {
+	Roles map[string]RolePolicy `json:"roles"`
+}
+
+// RolePolicy lists the permissions of a role
+type RolePolicy struct {
+	Inherits    []string `json:"inherits,omitempty"`    // Roles whose permissions are included
+	Permissions []string `json:"permissions,omitempty"` // Permissions or prefixes ending in "*"
+}
+
+// DefaultPolicy is used when no policy file is configured: patrons browse
+// the catalog and place, cancel and list their own holds, librarians run the
+// circulation desk, including holds on behalf of any member, and maintain
+// the catalog, and admins may do anything, including deleting. A patron is
+// linked to their member record by the "member_id" claim of their token.
+var DefaultPolicy = Policy{Roles: map[string]RolePolicy{
+	"patron": {Permissions: []string{
+		PermBooksRead, PermAuthorsRead, PermBranchesRead, PermCopiesRead,
+		PermHoldsReadOwn, PermHoldsWriteOwn,
+	}},
+	"librarian": {Inherits: []string{"patron"}, Permissions: []string{
+		PermBooksWrite, PermBooksTransfer, PermAuthorsWrite, PermCopiesWrite,
+		PermMembersRead, PermMembersWrite, "loans.*", PermHoldsRead, PermHoldsWrite, PermHoldsFulfill,
+		PermFeesRead, PermFeesCharge, PermFeesPay,
+	}},
+	"admin": {Permissions: []string{"*"}},
+}}
+
+// rolePermissions is a compiled Policy: the full set of permissions of
+// every role
+type rolePermissions map[string]map[string]bool
+
+// compile expands the inherited roles and prefixes of a policy. Unknown
+// permissions and roles are reported, so that a typo cannot silently
+// revoke access.
+func (p Policy) compile() (rolePermissions, error) {
+	known := make(map[string]bool)
+	for _, perm := range methodPermissions {
+		known[perm] = true
+	}
+	for _, perm := range ownMethodPermissions {
+		known[perm] = true
+	}
+
+	compiled := make(rolePermissions, len(p.Roles))
+	var expand func(role string, path []string) (map[string]bool, error)
+	expand = func(role string, path []string) (map[string]bool, error) {
+		if perms, ok := compiled[role]; ok {
+			return perms, nil
+		}
+		for _, r := range path {
+			if r == role {
+				return nil, fmt.Errorf("role %s inherits from itself through %s", role, strings.Join(path, ", "))
+			}
+		}
+		def, ok := p.Roles[role]
+		if !ok {
+			return nil, fmt.Errorf("role %s inherits from unknown role %s", path[len(path)-1], role)
+		}
+
+		perms := make(map[string]bool)
+		for _, parent := range def.Inherits {
+			inherited, err := expand(parent, append(path, role))
+			if err != nil {
+				return nil, err
+			}
+			for perm := range inherited {
+				perms[perm] = true
+			}
+		}
+		for _, pattern := range def.Permissions {
+			matched := false
+			for perm := range known {
+				if pattern == perm || (strings.HasSuffix(pattern, "*") && strings.HasPrefix(perm, strings.TrimSuffix(pattern, "*"))) {
+					perms[perm] = true
+					matched = true
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("role %s: unknown permission %q", role, pattern)
+			}
+		}
+		compiled[role] = perms
+		return perms, nil
+	}
+
+	for role := range p.Roles {
+		if _, err := expand(role, nil); err != nil {
+			return nil, err
+		}
+	}
+	return compiled, nil
+}
+
+// loadPolicy reads and compiles a policy file
+func loadPolicy(path string) (rolePermissions, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var p Policy
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&p); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	compiled, err := p.compile()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return compiled, nil
+}
+
+// Authorizer enforces a Policy on the Principal of every RPC. The policy
+// file is checked for changes every few seconds and reloaded without a
+// restart; a file that fails to load leaves the previous policy in force.
+type Authorizer struct {
+	now func() time.Time
+
+	mu     sync.Mutex
+	policy rolePermissions
+	file   *watchedFile // nil for the DefaultPolicy
+}
+
+// NewAuthorizer creates an Authorizer enforcing the policy in path, or the
+// DefaultPolicy if path is empty
+func NewAuthorizer(path string) (*Authorizer, error) {
+	a := &Authorizer{now: time.Now}
+	if path == "" {
+		policy, err := DefaultPolicy.compile()
+		if err != nil {
+			return nil, err
+		}
+		a.policy = policy
+		return a, nil
+	}
+
+	a.file = &watchedFile{path: path, checked: a.now()}
+	if info, err := os.Stat(path); err == nil {
+		a.file.modTime = info.ModTime()
+	}
+	policy, err := loadPolicy(path)
+	if err != nil {
+		return nil, err
+	}
+	a.policy = policy
+	return a, nil
+}
+
+// currentPolicy returns the policy in force, first rereading the policy
+// file if it changed on disk
+func (a *Authorizer) currentPolicy() rolePermissions {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.file != nil && a.file.changed(a.now()) {
+		if policy, err := loadPolicy(a.file.path); err != nil {
+			log.Printf("Failed to reload %s, keeping the previous policy: %v", a.file.path, err)
+		} else {
+			a.policy = policy
+			a.file.loaded()
+			log.Printf("Policy reloaded from %s", a.file.path)
+		}
+	}
+	return a.policy
+}
+
+// granted reports whether any of roles has perm in policy
+func granted(policy rolePermissions, roles []string, perm string) bool {
+	for _, role := range roles {
+		if policy[role][perm] {
+			return true
+		}
+	}
+	return false
+}
+
+// authorize checks that the caller in ctx may call method with req, which
+// is nil for streaming RPCs, and returns a PermissionDenied status error
+// otherwise
+func (a *Authorizer) authorize(ctx context.Context, method string, req any) error {
+	p, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return status.Error(codes.PermissionDenied, "caller is not authenticated")
+	}
+	perm, ok := methodPermissions[method]
+	if !ok {
+		log.Printf("Denied %s to %s: method has no permission", method, p.Subject)
+		return status.Errorf(codes.PermissionDenied, "%s is not available", method)
+	}
+	policy := a.currentPolicy()
+	if granted(policy, p.Roles, perm) {
+		return nil
+	}
+	if own, ok := ownMethodPermissions[method]; ok && granted(policy, p.Roles, own) {
+		if r, ok := req.(memberRequest); ok && p.MemberID != 0 && r.GetMemberId() == p.MemberID {
+			return nil
+		}
+		log.Printf("Denied %s to %s linked to member %d: request names another member", method, p.Subject, p.MemberID)
+		return status.Errorf(codes.PermissionDenied, "%s is only allowed for the member linked to the caller without the %s permission", method, perm)
+	}
+	log.Printf("Denied %s to %s with roles %v", method, p.Subject, p.Roles)
+	return status.Errorf(codes.PermissionDenied, "%s requires the %s permission", method, perm)
+}
+
+// UnaryInterceptor rejects unary RPCs the caller has no permission for. It
+// must run after the Authenticator's.
+func (a *Authorizer) UnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := a.authorize(ctx, info.FullMethod, req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor rejects streaming RPCs the caller has no permission
+// for. It must run after the Authenticator's.
+func (a *Authorizer) StreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := a.authorize(ss.Context(), info.FullMethod, nil); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
@@ -0,0 +1,209 @@
+package server
+
+import (
+	"context"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMethodPermissionsCoverEveryRPC(t *testing.T) {
+	for _, desc := range []grpc.ServiceDesc{
+		pb.LibraryService_ServiceDesc, pb.AuthorService_ServiceDesc, pb.BranchService_ServiceDesc,
+		pb.CopyService_ServiceDesc, pb.MemberService_ServiceDesc, pb.CirculationService_ServiceDesc,
+		pb.HoldService_ServiceDesc, pb.FeeService_ServiceDesc,
+	} {
+		var names []string
+		for _, m := range desc.Methods {
+			names = append(names, m.MethodName)
+		}
+		for _, s := range desc.Streams {
+			names = append(names, s.StreamName)
+		}
+		for _, name := range names {
+			method := "/" + desc.ServiceName + "/" + name
+			if _, ok := methodPermissions[method]; !ok {
+				t.Errorf("%s has no permission", method)
+			}
+		}
+	}
+}
+
+// callAs authorizes method for a caller with roles
+func callAs(a *Authorizer, method string, roles ...string) codes.Code {
+	ctx := withPrincipal(context.Background(), &Principal{Subject: "test", Roles: roles})
+	return status.Code(a.authorize(ctx, method, nil))
+}
+
+func TestDefaultPolicy(t *testing.T) {
+	a, err := NewAuthorizer("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	denied := codes.PermissionDenied
+	for _, tc := range []struct {
+		method string
+		role   string
+		want   codes.Code
+	}{
+		{pb.LibraryService_GetBook_FullMethodName, "patron", codes.OK},
+		{pb.LibraryService_ListBooks_FullMethodName, "patron", codes.OK},
+		{pb.LibraryService_CreateBook_FullMethodName, "patron", denied},
+		{pb.LibraryService_DeleteBook_FullMethodName, "patron", denied},
+		{pb.HoldService_PlaceHold_FullMethodName, "patron", denied},
+		{pb.HoldService_CancelHold_FullMethodName, "patron", denied},
+		{pb.CirculationService_CheckoutBook_FullMethodName, "patron", denied},
+		{pb.FeeService_GetBalance_FullMethodName, "patron", denied},
+
+		{pb.LibraryService_GetBook_FullMethodName, "librarian", codes.OK},
+		{pb.LibraryService_CreateBook_FullMethodName, "librarian", codes.OK},
+		{pb.LibraryService_UpdateBook_FullMethodName, "librarian", codes.OK},
+		{pb.LibraryService_DeleteBook_FullMethodName, "librarian", denied},
+		{pb.HoldService_PlaceHold_FullMethodName, "librarian", codes.OK},
+		{pb.CirculationService_CheckoutBook_FullMethodName, "librarian", codes.OK},
+		{pb.FeeService_WaiveCharge_FullMethodName, "librarian", denied},
+
+		{pb.LibraryService_DeleteBook_FullMethodName, "admin", codes.OK},
+		{pb.FeeService_WaiveCharge_FullMethodName, "admin", codes.OK},
+
+		{pb.LibraryService_GetBook_FullMethodName, "visitor", denied},
+		{"/library.LibraryService/Unknown", "admin", denied},
+	} {
+		if got := callAs(a, tc.method, tc.role); got != tc.want {
+			t.Errorf("%s as %s: got %v, want %v", tc.method, tc.role, got, tc.want)
+		}
+	}
+
+	if got := callAs(a, pb.LibraryService_DeleteBook_FullMethodName, "patron", "admin"); got != codes.OK {
+		t.Errorf("any role should grant a permission, got %v", got)
+	}
+	if got := status.Code(a.authorize(context.Background(), pb.LibraryService_GetBook_FullMethodName, nil)); got != denied {
+		t.Errorf("call without a principal: got %v, want %v", got, denied)
+	}
+}
+
+func TestPatronsHoldForThemselves(t *testing.T) {
+	a, err := NewAuthorizer("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	denied := codes.PermissionDenied
+	for _, tc := range []struct {
+		name     string
+		role     string
+		memberID int32 // Member linked to the caller
+		method   string
+		req      any
+		want     codes.Code
+	}{
+		{"place own hold", "patron", 7, pb.HoldService_PlaceHold_FullMethodName, &pb.PlaceHoldRequest{BookId: 1, MemberId: 7}, codes.OK},
+		{"cancel own hold", "patron", 7, pb.HoldService_CancelHold_FullMethodName, &pb.CancelHoldRequest{BookId: 1, MemberId: 7}, codes.OK},
+		{"list own holds", "patron", 7, pb.HoldService_ListHolds_FullMethodName, &pb.ListHoldsRequest{MemberId: 7}, codes.OK},
+		{"place a hold for another member", "patron", 7, pb.HoldService_PlaceHold_FullMethodName, &pb.PlaceHoldRequest{BookId: 1, MemberId: 8}, denied},
+		{"cancel a hold of another member", "patron", 7, pb.HoldService_CancelHold_FullMethodName, &pb.CancelHoldRequest{BookId: 1, MemberId: 8}, denied},
+		{"list every hold", "patron", 7, pb.HoldService_ListHolds_FullMethodName, &pb.ListHoldsRequest{BookId: 1}, denied},
+		{"place a hold without a linked member", "patron", 0, pb.HoldService_PlaceHold_FullMethodName, &pb.PlaceHoldRequest{BookId: 1}, denied},
+		{"fulfill a hold", "patron", 7, pb.HoldService_FulfillNextHold_FullMethodName, &pb.FulfillNextHoldRequest{BookId: 1}, denied},
+		{"place a hold for any member", "librarian", 0, pb.HoldService_PlaceHold_FullMethodName, &pb.PlaceHoldRequest{BookId: 1, MemberId: 8}, codes.OK},
+	} {
+		ctx := withPrincipal(context.Background(), &Principal{Subject: "test", Roles: []string{tc.role}, MemberID: tc.memberID})
+		if got := status.Code(a.authorize(ctx, tc.method, tc.req)); got != tc.want {
+			t.Errorf("%s as %s: got %v, want %v", tc.name, tc.role, got, tc.want)
+		}
+	}
+}
+
+func TestPolicyCompileErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		policy Policy
+		want   string
+	}{
+		{"unknown permission", Policy{Roles: map[string]RolePolicy{
+			"patron": {Permissions: []string{"book.read"}},
+		}}, "unknown permission"},
+		{"unknown prefix", Policy{Roles: map[string]RolePolicy{
+			"patron": {Permissions: []string{"nothing.*"}},
+		}}, "unknown permission"},
+		{"unknown role", Policy{Roles: map[string]RolePolicy{
+			"librarian": {Inherits: []string{"patron"}},
+		}}, "unknown role"},
+		{"cycle", Policy{Roles: map[string]RolePolicy{
+			"a": {Inherits: []string{"b"}},
+			"b": {Inherits: []string{"a"}},
+		}}, "inherits from itself"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.policy.compile()
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Errorf("got error %v, want one containing %q", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestPolicyPrefixAndInheritance(t *testing.T) {
+	compiled, err := Policy{Roles: map[string]RolePolicy{
+		"reader": {Permissions: []string{"books.read"}},
+		"clerk":  {Inherits: []string{"reader"}, Permissions: []string{"loans.*"}},
+	}}.compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	clerk := compiled["clerk"]
+	for _, perm := range []string{PermBooksRead, PermLoansRead, PermLoansWrite} {
+		if !clerk[perm] {
+			t.Errorf("clerk lacks %s", perm)
+		}
+	}
+	if clerk[PermBooksWrite] || compiled["reader"][PermLoansRead] {
+		t.Error("permissions leaked between roles")
+	}
+}
+
+func TestAuthorizerReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.json")
+	write := func(content string, modTime time.Time) {
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	start := time.Now()
+	write(`{"roles": {"patron": {"permissions": ["books.read"]}}}`, start)
+
+	a, err := NewAuthorizer(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := a.file.checked
+	a.now = func() time.Time { return now }
+	method := pb.LibraryService_CreateBook_FullMethodName
+	if got := callAs(a, method, "patron"); got != codes.PermissionDenied {
+		t.Fatalf("got %v before the reload, want PermissionDenied", got)
+	}
+
+	write(`{"roles": {"patron": {"permissions": ["books.*"]}}}`, start.Add(time.Second))
+	if got := callAs(a, method, "patron"); got != codes.PermissionDenied {
+		t.Errorf("got %v within the check interval, want the previous policy", got)
+	}
+	now = now.Add(reloadCheckInterval)
+	if got := callAs(a, method, "patron"); got != codes.OK {
+		t.Errorf("got %v after the reload, want OK", got)
+	}
+
+	// A broken policy keeps the previous one in force
+	write(`{"roles": {"patron": {"permissions": ["books.typo"]}}}`, start.Add(2*time.Second))
+	now = now.Add(reloadCheckInterval)
+	if got := callAs(a, method, "patron"); got != codes.OK {
+		t.Errorf("got %v after a failed reload, want the previous policy", got)
+	}
+}
@@ -0,0 +1,496 @@
+package server
+
+import (
+	"cmp"
+	"container/heap"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"google.golang.org/grpc/codes"
+	"hash/fnv"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// bookField describes a Book field that can be used in filters and ordering.
+// Exactly one of str and num is set.
+type bookField struct {
+	str func(b *pb.Book) string
+	num func(b *pb.Book) int64
+}
+
+// bookFields lists the fields accepted in filter and order_by expressions
+var bookFields = map[string]bookField{
+	"id":               {num: func(b *pb.Book) int64 { return int64(b.Id) }},
+	"title":            {str: func(b *pb.Book) string { return b.Title }},
+	"author":           {str: func(b *pb.Book) string { return b.Author }},
+	"isbn":             {str: func(b *pb.Book) string { return b.Isbn }},
+	"publication_year": {num: func(b *pb.Book) int64 { return int64(b.PublicationYear) }},
+	"genre":            {str: func(b *pb.Book) string { return b.Genre }},
+}
+
+// compareField orders two books by a single field. String fields are
+// compared case-insensitively.
+func compareField(f bookField, a, b *pb.Book) int {
+	if f.num != nil {
+		x, y := f.num(a), f.num(b)
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(strings.ToLower(f.str(a)), strings.ToLower(f.str(b)))
+}
+
+// orderField is one entry of an order_by clause
+type orderField struct {
+	name string
+	desc bool
+}
+
+// bookOrder is a parsed order_by clause. It always ends with the book ID so
+// that the ordering is total and page boundaries are stable.
+type bookOrder []orderField
+
+// parseOrderBy parses an AIP-132 order_by string such as
+// "author, publication_year desc"
+func parseOrderBy(s string) (bookOrder, error) {
+	var order bookOrder
+	hasID := false
+	for _, part := range strings.Split(s, ",") {
+		words := strings.Fields(part)
+		if len(words) == 0 {
+			if strings.TrimSpace(s) == "" {
+				break
+			}
+			return nil, fmt.Errorf("empty field in order_by")
+		}
+		if _, ok := bookFields[words[0]]; !ok {
+			return nil, fmt.Errorf("unknown order_by field %q", words[0])
+		}
+		f := orderField{name: words[0]}
+		switch {
+		case len(words) == 1:
+		case len(words) == 2 && words[1] == "desc":
+			f.desc = true
+		case len(words) == 2 && words[1] == "asc":
+		default:
+			return nil, fmt.Errorf("invalid order_by clause %q", strings.TrimSpace(part))
+		}
+		hasID = hasID || f.name == "id"
+		order = append(order, f)
+	}
+	if !hasID {
+		order = append(order, orderField{name: "id"})
+	}
+	return order, nil
+}
+
+// compare returns the relative position of two books in the ordering
+func (o bookOrder) compare(a, b *pb.Book) int {
+	for _, f := range o {
+		if c := compareField(bookFields[f.name], a, b); c != 0 {
+			if f.desc {
+				return -c
+			}
+			return c
+		}
+	}
+	return 0
+}
+
+// bookFilter reports whether a book matches a filter expression
+type bookFilter func(b *pb.Book) bool
+
+// parseFilter parses an AIP-160 style filter. Supported are comparisons of
+// the fields in bookFields with =, !=, <, <=, > and >=, combined with AND,
+// OR, NOT (or a leading "-") and parentheses. String comparisons ignore
+// case, and a trailing "*" in a value given to = matches a prefix, e.g.
+// title = "Harry*". As in AIP-160, OR binds tighter than AND.
+func parseFilter(s string) (bookFilter, error) {
+	tokens, err := tokenizeFilter(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &filterParser{tokens: tokens}
+	if len(p.tokens) == 0 {
+		return func(*pb.Book) bool { return true }, nil
+	}
+	f, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %q in filter", p.tokens[p.pos].text)
+	}
+	return f, nil
+}
+
+// filterToken is a lexical token of a filter expression
+type filterToken struct {
+	text   string
+	quoted bool
+}
+
+// tokenizeFilter splits a filter into words, quoted strings, comparators
+// and parentheses. Inside quotes a backslash escapes the next character.
+func tokenizeFilter(s string) ([]filterToken, error) {
+	var tokens []filterToken
+	r := []rune(s)
+	for i := 0; i < len(r); {
+		c := r[i]
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '-' && (i+1 == len(r) || !unicode.IsDigit(r[i+1])):
+			tokens = append(tokens, filterToken{text: "-"})
+			i++
+		case c == '(' || c == ')':
+			tokens = append(tokens, filterToken{text: string(c)})
+			i++
+		case c == '"' || c == '\'':
+			j := i + 1
+			var sb strings.Builder
+			for j < len(r) && r[j] != c {
+				if r[j] == '\\' {
+					j++
+					if j == len(r) {
+						return nil, fmt.Errorf("trailing backslash in filter")
+					}
+				}
+				sb.WriteRune(r[j])
+				j++
+			}
+			if j == len(r) {
+				return nil, fmt.Errorf("unterminated quoted string at offset %d in filter", i)
+			}
+			tokens = append(tokens, filterToken{text: sb.String(), quoted: true})
+			i = j + 1
+		case strings.ContainsRune("=!<>", c):
+			j := i + 1
+			if j < len(r) && r[j] == '=' {
+				j++
+			}
+			tokens = append(tokens, filterToken{text: string(r[i:j])})
+			i = j
+		default:
+			j := i
+			for j < len(r) && !unicode.IsSpace(r[j]) && !strings.ContainsRune("()=!<>\"'", r[j]) {
+				j++
+			}
+			tokens = append(tokens, filterToken{text: string(r[i:j])})
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+// filterParser is a recursive descent parser over filter tokens
+type filterParser struct {
+	tokens []filterToken
+	pos    int
+}
+
+// peek returns the next unquoted token text, or "" at the end
+func (p *filterParser) peek() string {
+	if p.pos >= len(p.tokens) || p.tokens[p.pos].quoted {
+		return ""
+	}
+	return p.tokens[p.pos].text
+}
+
+// parseAnd parses a sequence of terms joined by AND or juxtaposition
+func (p *filterParser) parseAnd() (bookFilter, error) {
+	left, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	for p.pos < len(p.tokens) && p.peek() != ")" {
+		if p.peek() == "AND" {
+			p.pos++
+		}
+		right, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		left = func(b *pb.Book) bool { return l(b) && right(b) }
+	}
+	return left, nil
+}
+
+// parseOr parses terms joined by OR
+func (p *filterParser) parseOr() (bookFilter, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "OR" {
+		p.pos++
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		left = func(b *pb.Book) bool { return l(b) || right(b) }
+	}
+	return left, nil
+}
+
+// parseNot parses an optionally negated term
+func (p *filterParser) parseNot() (bookFilter, error) {
+	if p.peek() == "NOT" || p.peek() == "-" {
+		p.pos++
+		f, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return func(b *pb.Book) bool { return !f(b) }, nil
+	}
+	if p.peek() == "(" {
+		p.pos++
+		f, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			return nil, fmt.Errorf("missing closing parenthesis in filter")
+		}
+		p.pos++
+		return f, nil
+	}
+	return p.parseRestriction()
+}
+
+// parseRestriction parses a single "field comparator value" comparison
+func (p *filterParser) parseRestriction() (bookFilter, error) {
+	if p.pos+3 > len(p.tokens) {
+		return nil, fmt.Errorf("incomplete comparison in filter")
+	}
+	name, op, value := p.tokens[p.pos], p.tokens[p.pos+1], p.tokens[p.pos+2]
+	p.pos += 3
+
+	field, ok := bookFields[name.text]
+	if !ok || name.quoted {
+		return nil, fmt.Errorf("unknown filter field %q", name.text)
+	}
+
+	var cmp func(b *pb.Book) int
+	if field.num != nil {
+		n, err := strconv.ParseInt(value.text, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s expects a number, got %q", name.text, value.text)
+		}
+		cmp = func(b *pb.Book) int {
+			v := field.num(b)
+			switch {
+			case v < n:
+				return -1
+			case v > n:
+				return 1
+			}
+			return 0
+		}
+	} else {
+		want := strings.ToLower(value.text)
+		if prefix, ok := strings.CutSuffix(want, "*"); ok && (op.text == "=" || op.text == "!=") {
+			match := func(b *pb.Book) bool { return strings.HasPrefix(strings.ToLower(field.str(b)), prefix) }
+			if op.text == "!=" {
+				return func(b *pb.Book) bool { return !match(b) }, nil
+			}
+			return match, nil
+		}
+		cmp = func(b *pb.Book) int { return strings.Compare(strings.ToLower(field.str(b)), want) }
+	}
+
+	switch op.text {
+	case "=":
+		return func(b *pb.Book) bool { return cmp(b) == 0 }, nil
+	case "!=":
+		return func(b *pb.Book) bool { return cmp(b) != 0 }, nil
+	case "<":
+		return func(b *pb.Book) bool { return cmp(b) < 0 }, nil
+	case "<=":
+		return func(b *pb.Book) bool { return cmp(b) <= 0 }, nil
+	case ">":
+		return func(b *pb.Book) bool { return cmp(b) > 0 }, nil
+	case ">=":
+		return func(b *pb.Book) bool { return cmp(b) >= 0 }, nil
+	}
+	return nil, fmt.Errorf("unsupported operator %q in filter", op.text)
+}
+
+// sortKey holds the values of the order_by fields of a book, numbers in
+// decimal, so that a page token can carry where the previous page ended
+// without carrying the whole book
+type sortKey []string
+
+// key returns the values of the fields of o in b
+func (o bookOrder) key(b *pb.Book) sortKey {
+	k := make(sortKey, len(o))
+	for i, f := range o {
+		field := bookFields[f.name]
+		if field.num != nil {
+			k[i] = strconv.FormatInt(field.num(b), 10)
+		} else {
+			k[i] = field.str(b)
+		}
+	}
+	return k
+}
+
+// compareKey returns the position of b in the ordering relative to the book
+// with key k, which checkKey has accepted
+func (o bookOrder) compareKey(b *pb.Book, k sortKey) int {
+	for i, f := range o {
+		field := bookFields[f.name]
+		var c int
+		if field.num != nil {
+			n, _ := strconv.ParseInt(k[i], 10, 64)
+			c = cmp.Compare(field.num(b), n)
+		} else {
+			c = strings.Compare(strings.ToLower(field.str(b)), strings.ToLower(k[i]))
+		}
+		if c != 0 {
+			if f.desc {
+				return -c
+			}
+			return c
+		}
+	}
+	return 0
+}
+
+// checkKey reports whether k can be a key of o
+func (o bookOrder) checkKey(k sortKey) bool {
+	if len(k) != len(o) {
+		return false
+	}
+	for i, f := range o {
+		if bookFields[f.name].num != nil {
+			if _, err := strconv.ParseInt(k[i], 10, 64); err != nil {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// pageToken is the decoded form of an opaque page token. It holds the sort
+// key of the last book returned, which the next page starts after, the
+// number of matching books counted for the first page, and a fingerprint of
+// the query so that a token cannot be reused with a different filter, order
+// or branch.
+type pageToken struct {
+	Query uint64  `json:"q"`
+	Last  sortKey `json:"l"`
+	Total int32   `json:"t"`
+}
+
+// queryFingerprint identifies the filter, order and branch of a list request
+func queryFingerprint(filter, orderBy string, branchID int32) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(filter))
+	h.Write([]byte{0})
+	h.Write([]byte(orderBy))
+	h.Write([]byte{0})
+	h.Write([]byte(strconv.Itoa(int(branchID))))
+	return h.Sum64()
+}
+
+// encodePageToken returns the opaque form of a page token
+func encodePageToken(t pageToken) string {
+	data, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodePageToken parses a token and checks that it belongs to the query
+// with the given fingerprint and order
+func decodePageToken(token string, fingerprint uint64, order bookOrder) (*pageToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid page token")
+	}
+	t := &pageToken{}
+	if err := json.Unmarshal(data, t); err != nil {
+		return nil, fmt.Errorf("invalid page token")
+	}
+	if t.Query != fingerprint {
+		return nil, fmt.Errorf("page token does not match the filter and order_by of the request")
+	}
+	if !order.checkKey(t.Last) {
+		return nil, fmt.Errorf("invalid page token")
+	}
+	return t, nil
+}
+
+// firstBooks keeps the first n books of an order among those added, with
+// the last of them on top of the heap so that it is dropped in O(log n)
+type firstBooks struct {
+	order bookOrder
+	n     int
+	books []*pb.Book
+}
+
+func (h *firstBooks) Len() int           { return len(h.books) }
+func (h *firstBooks) Less(i, j int) bool { return h.order.compare(h.books[i], h.books[j]) > 0 }
+func (h *firstBooks) Swap(i, j int)      { h.books[i], h.books[j] = h.books[j], h.books[i] }
+func (h *firstBooks) Push(x any)         { h.books = append(h.books, x.(*pb.Book)) }
+func (h *firstBooks) Pop() any {
+	b := h.books[len(h.books)-1]
+	h.books = h.books[:len(h.books)-1]
+	return b
+}
+
+// add keeps b if it is among the first n books added so far
+func (h *firstBooks) add(b *pb.Book) {
+	switch {
+	case len(h.books) < h.n:
+		heap.Push(h, b)
+	case h.order.compare(b, h.books[0]) < 0:
+		h.books[0] = b
+		heap.Fix(h, 0)
+	}
+}
+
+// sorted returns the books kept, in order
+func (h *firstBooks) sorted() []*pb.Book {
+	slices.SortFunc(h.books, h.order.compare)
+	return h.books
+}
+
+// encodeIDPageToken returns the token for the page after the record with
+// the given ID, for lists ordered by ID
+func encodeIDPageToken(id int32) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(int64(id), 10)))
+}
+
+// decodeIDPageToken returns the ID of the last record of the previous page
+func decodeIDPageToken(token string) (int32, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, fmt.Errorf("invalid page token")
+	}
+	id, err := strconv.ParseInt(string(data), 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid page token")
+	}
+	return int32(id), nil
+}
+
+// resolvePageSize applies the default and maximum to a requested page size
+func resolvePageSize(field string, size int32, def, max int) (int, error) {
+	switch {
+	case size < 0:
+		return 0, fieldError(codes.InvalidArgument, field, "must not be negative")
+	case size == 0:
+		return def, nil
+	}
+	return min(int(size), max), nil
+}
@@ -0,0 +1,39 @@
+package server
+
+import (
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"testing"
+)
+
+func TestParseFilter(t *testing.T) {
+	book := &pb.Book{Title: `Say "Hi"`, Author: `C:\Users`, PublicationYear: 1999}
+	tests := []struct {
+		filter  string
+		match   bool
+		wantErr bool
+	}{
+		{filter: ``, match: true},
+		{filter: `title = "Say \"Hi\""`, match: true},
+		{filter: `author = 'C:\\Users'`, match: true},
+		{filter: `publication_year > 1990 AND NOT title = "Say*"`, match: false},
+		{filter: `title = "unterminated`, wantErr: true},
+		{filter: `title = 'unterminated`, wantErr: true},
+		{filter: `title = "trailing\`, wantErr: true},
+		{filter: `title = "escaped quote\"`, wantErr: true},
+		{filter: `(title = "x"`, wantErr: true},
+		{filter: `shelf = 3`, wantErr: true},
+	}
+	for _, tc := range tests {
+		f, err := parseFilter(tc.filter)
+		switch {
+		case tc.wantErr:
+			if err == nil {
+				t.Errorf("parseFilter(%q) succeeded, want an error", tc.filter)
+			}
+		case err != nil:
+			t.Errorf("parseFilter(%q): %v", tc.filter, err)
+		case f(book) != tc.match:
+			t.Errorf("parseFilter(%q) matched %v, want %v", tc.filter, !tc.match, tc.match)
+		}
+	}
+}
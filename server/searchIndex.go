@@ -0,0 +1,468 @@
+package server
+
+import (
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"html"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// searchField is a Book field covered by the search index
+type searchField struct {
+	name   string
+	weight float64 // Relative importance of a match in this field
+	value  func(b *pb.Book) string
+	whole  bool // Index the field as a single token, ignoring punctuation
+}
+
+// searchFields lists the indexed fields
+var searchFields = []searchField{
+	{name: "title", weight: 3, value: func(b *pb.Book) string { return b.Title }},
+	{name: "author", weight: 2, value: func(b *pb.Book) string { return b.Author }},
+	{name: "genre", weight: 1, value: func(b *pb.Book) string { return b.Genre }},
+	{name: "isbn", weight: 1, value: func(b *pb.Book) string { return b.Isbn }, whole: true},
+}
+
+// BM25 parameters
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// Weights applied to approximate matches relative to an exact match
+const (
+	prefixMatchWeight = 0.8
+	typoMatchWeight   = 0.6
+)
+
+// gramSize is the length of the character n-grams indexing the vocabulary,
+// so that the terms close to a query word are found without scanning every
+// term. Terms are padded with gramStart and gramEnd, which tokenize never
+// keeps in a term.
+const (
+	gramSize  = 3
+	gramStart = '^'
+	gramEnd   = '$'
+)
+
+// token is a normalized word and its byte range in the original text
+type token struct {
+	term       string
+	start, end int
+}
+
+// tokenize splits text into lowercase words of letters and digits
+func tokenize(text string) []token {
+	var tokens []token
+	start := -1
+	for i, r := range text {
+		isWord := unicode.IsLetter(r) || unicode.IsDigit(r)
+		switch {
+		case isWord && start < 0:
+			start = i
+		case !isWord && start >= 0:
+			tokens = append(tokens, token{term: strings.ToLower(text[start:i]), start: start, end: i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, token{term: strings.ToLower(text[start:]), start: start, end: len(text)})
+	}
+	return tokens
+}
+
+// fieldTokens tokenizes the value of a field for indexing
+func fieldTokens(f searchField, text string) []token {
+	if !f.whole {
+		return tokenize(text)
+	}
+	var sb strings.Builder
+	for _, t := range tokenize(text) {
+		sb.WriteString(t.term)
+	}
+	if sb.Len() == 0 {
+		return nil
+	}
+	return []token{{term: sb.String(), start: 0, end: len(text)}}
+}
+
+// indexedBook is a book as seen by the index
+type indexedBook struct {
+	book  *pb.Book
+	terms map[string][]int // Term frequency per field
+	lens  []int            // Number of tokens per field
+}
+
+// searchIndex is an inverted index over the searchFields of every book
+type searchIndex struct {
+	mu       sync.RWMutex
+	postings map[string]map[int32]struct{}  // Books containing each term
+	grams    map[string]map[string]struct{} // Terms containing each n-gram
+	lengths  map[int]map[string]struct{}    // Terms of each length in runes
+	books    map[int32]*indexedBook
+	totalLen []int // Sum of field lengths, for average field length
+}
+
+// newSearchIndex creates an empty index
+func newSearchIndex() *searchIndex {
+	return &searchIndex{
+		postings: make(map[string]map[int32]struct{}),
+		grams:    make(map[string]map[string]struct{}),
+		lengths:  make(map[int]map[string]struct{}),
+		books:    make(map[int32]*indexedBook),
+		totalLen: make([]int, len(searchFields)),
+	}
+}
+
+// termGrams returns the distinct n-grams of a term padded at the start and,
+// if end is set, at the end
+func termGrams(term string, end bool) []string {
+	runes := append([]rune{gramStart}, []rune(term)...)
+	if end {
+		runes = append(runes, gramEnd)
+	}
+	var grams []string
+	seen := make(map[string]bool)
+	for i := 0; i+gramSize <= len(runes); i++ {
+		g := string(runes[i : i+gramSize])
+		if !seen[g] {
+			seen[g] = true
+			grams = append(grams, g)
+		}
+	}
+	return grams
+}
+
+// addTerm adds a new term to the vocabulary indexes
+func (idx *searchIndex) addTerm(term string) {
+	for _, g := range termGrams(term, true) {
+		terms, ok := idx.grams[g]
+		if !ok {
+			terms = make(map[string]struct{})
+			idx.grams[g] = terms
+		}
+		terms[term] = struct{}{}
+	}
+	n := utf8.RuneCountInString(term)
+	if idx.lengths[n] == nil {
+		idx.lengths[n] = make(map[string]struct{})
+	}
+	idx.lengths[n][term] = struct{}{}
+}
+
+// removeTerm drops a term no book contains anymore from the vocabulary
+// indexes
+func (idx *searchIndex) removeTerm(term string) {
+	for _, g := range termGrams(term, true) {
+		delete(idx.grams[g], term)
+		if len(idx.grams[g]) == 0 {
+			delete(idx.grams, g)
+		}
+	}
+	n := utf8.RuneCountInString(term)
+	delete(idx.lengths[n], term)
+	if len(idx.lengths[n]) == 0 {
+		delete(idx.lengths, n)
+	}
+}
+
+// termsWithGrams returns the terms containing at least min of grams
+func (idx *searchIndex) termsWithGrams(grams []string, min int) map[string]struct{} {
+	counts := make(map[string]int)
+	for _, g := range grams {
+		for term := range idx.grams[g] {
+			counts[term]++
+		}
+	}
+	terms := make(map[string]struct{})
+	for term, n := range counts {
+		if n >= min {
+			terms[term] = struct{}{}
+		}
+	}
+	return terms
+}
+
+// put adds or replaces a book in the index
+func (idx *searchIndex) put(book *pb.Book) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.removeLocked(book.Id)
+	doc := &indexedBook{book: book, terms: make(map[string][]int), lens: make([]int, len(searchFields))}
+	for i, f := range searchFields {
+		tokens := fieldTokens(f, f.value(book))
+		doc.lens[i] = len(tokens)
+		idx.totalLen[i] += len(tokens)
+		for _, t := range tokens {
+			freq, ok := doc.terms[t.term]
+			if !ok {
+				freq = make([]int, len(searchFields))
+				doc.terms[t.term] = freq
+			}
+			freq[i]++
+		}
+	}
+	for term := range doc.terms {
+		ids, ok := idx.postings[term]
+		if !ok {
+			ids = make(map[int32]struct{})
+			idx.postings[term] = ids
+			idx.addTerm(term)
+		}
+		ids[book.Id] = struct{}{}
+	}
+	idx.books[book.Id] = doc
+}
+
+// remove drops a book from the index
+func (idx *searchIndex) remove(id int32) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.removeLocked(id)
+}
+
+// removeLocked drops a book from the index with idx.mu held
+func (idx *searchIndex) removeLocked(id int32) {
+	doc, ok := idx.books[id]
+	if !ok {
+		return
+	}
+	for term := range doc.terms {
+		delete(idx.postings[term], id)
+		if len(idx.postings[term]) == 0 {
+			delete(idx.postings, term)
+			idx.removeTerm(term)
+		}
+	}
+	for i, n := range doc.lens {
+		idx.totalLen[i] -= n
+	}
+	delete(idx.books, id)
+}
+
+// searchHit is a scored search result
+type searchHit struct {
+	book       *pb.Book
+	score      float64
+	highlights []*pb.Highlight
+}
+
+// search returns the books matching any word of the query, most relevant
+// first. Unless exact is set, index terms starting with a query word or
+// within a small edit distance of it also match, with a lower weight.
+func (idx *searchIndex) search(query string, exact bool) []searchHit {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	n := float64(len(idx.books))
+	if n == 0 {
+		return nil
+	}
+
+	scores := make(map[int32]float64)
+	matched := make(map[int32]map[string]bool) // Index terms matched per book
+	seen := make(map[string]bool)
+	for _, q := range queryTerms(query) {
+		if seen[q] {
+			continue
+		}
+		seen[q] = true
+
+		// Best score of this query word per book over its expansions
+		best := make(map[int32]float64)
+		for term, weight := range idx.expand(q, exact) {
+			ids := idx.postings[term]
+			idf := math.Log(1 + (n-float64(len(ids))+0.5)/(float64(len(ids))+0.5))
+			for id := range ids {
+				doc := idx.books[id]
+				var s float64
+				for i, f := range searchFields {
+					tf := float64(doc.terms[term][i])
+					if tf == 0 {
+						continue
+					}
+					avg := float64(idx.totalLen[i]) / n
+					norm := 1 - bm25B + bm25B*float64(doc.lens[i])/math.Max(avg, 1)
+					s += f.weight * idf * tf * (bm25K1 + 1) / (tf + bm25K1*norm)
+				}
+				s *= weight
+				if s > best[id] {
+					best[id] = s
+				}
+				if matched[id] == nil {
+					matched[id] = make(map[string]bool)
+				}
+				matched[id][term] = true
+			}
+		}
+		for id, s := range best {
+			scores[id] += s
+		}
+	}
+
+	hits := make([]searchHit, 0, len(scores))
+	for id, score := range scores {
+		doc := idx.books[id]
+		hits = append(hits, searchHit{book: doc.book, score: score, highlights: highlight(doc.book, matched[id])})
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].score != hits[j].score {
+			return hits[i].score > hits[j].score
+		}
+		return hits[i].book.Id < hits[j].book.Id
+	})
+	return hits
+}
+
+// queryTerms tokenizes a query. Words containing punctuation, such as a
+// hyphenated ISBN, are also tried with the punctuation removed.
+func queryTerms(query string) []string {
+	var terms []string
+	for _, word := range strings.Fields(query) {
+		tokens := tokenize(word)
+		for _, t := range tokens {
+			terms = append(terms, t.term)
+		}
+		if len(tokens) > 1 {
+			var sb strings.Builder
+			for _, t := range tokens {
+				sb.WriteString(t.term)
+			}
+			terms = append(terms, sb.String())
+		}
+	}
+	return terms
+}
+
+// expand returns the index terms matching a query word and their weights.
+// Approximate matches are looked for among the terms sharing enough n-grams
+// with the word rather than in the whole vocabulary: a term starting with
+// the word contains all of its n-grams but the last, and each edit breaks
+// at most gramSize+1 n-grams. When that bound leaves nothing to require,
+// the terms of a close enough length are tried instead.
+func (idx *searchIndex) expand(q string, exact bool) map[string]float64 {
+	terms := make(map[string]float64)
+	if _, ok := idx.postings[q]; ok {
+		terms[q] = 1
+	}
+	if exact {
+		return terms
+	}
+
+	qLen := utf8.RuneCountInString(q)
+	if qLen >= 2 {
+		grams := termGrams(q, false)
+		for term := range idx.termsWithGrams(grams, len(grams)) {
+			if term != q && strings.HasPrefix(term, q) {
+				terms[term] = prefixMatchWeight
+			}
+		}
+	}
+
+	maxEdits := typoTolerance(q)
+	if maxEdits == 0 {
+		return terms
+	}
+	var candidates map[string]struct{}
+	grams := termGrams(q, true)
+	if shared := len(grams) - (gramSize+1)*maxEdits; shared > 0 {
+		candidates = idx.termsWithGrams(grams, shared)
+	} else {
+		candidates = make(map[string]struct{})
+		for n := qLen - maxEdits; n <= qLen+maxEdits; n++ {
+			for term := range idx.lengths[n] {
+				candidates[term] = struct{}{}
+			}
+		}
+	}
+	for term := range candidates {
+		if _, ok := terms[term]; ok || term == q {
+			continue
+		}
+		if d := utf8.RuneCountInString(term) - qLen; d > maxEdits || -d > maxEdits {
+			continue
+		}
+		if dist := editDistance(q, term, maxEdits); dist <= maxEdits {
+			terms[term] = typoMatchWeight / float64(dist)
+		}
+	}
+	return terms
+}
+
+// typoTolerance is the number of edits allowed for a query word; short
+// words must match exactly
+func typoTolerance(q string) int {
+	switch n := utf8.RuneCountInString(q); {
+	case n >= 8:
+		return 2
+	case n >= 4:
+		return 1
+	}
+	return 0
+}
+
+// editDistance computes the optimal string alignment distance between a
+// and b, where insertions, deletions, substitutions and transpositions of
+// adjacent letters each count as one edit. It gives up and returns max+1 as
+// soon as the distance is known to exceed max.
+func editDistance(a, b string, max int) int {
+	ra, rb := []rune(a), []rune(b)
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		rowMin := cur[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+			rowMin = min(rowMin, cur[j])
+		}
+		if rowMin > max {
+			return max + 1
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(rb)]
+}
+
+// highlight marks the matched terms in every field that contains one. The
+// field text is HTML-escaped so that only the added tags are markup.
+func highlight(book *pb.Book, terms map[string]bool) []*pb.Highlight {
+	var highlights []*pb.Highlight
+	for _, f := range searchFields {
+		text := f.value(book)
+		var sb strings.Builder
+		last, found := 0, false
+		for _, t := range fieldTokens(f, text) {
+			if !terms[t.term] {
+				continue
+			}
+			found = true
+			sb.WriteString(html.EscapeString(text[last:t.start]))
+			sb.WriteString("<em>")
+			sb.WriteString(html.EscapeString(text[t.start:t.end]))
+			sb.WriteString("</em>")
+			last = t.end
+		}
+		if found {
+			sb.WriteString(html.EscapeString(text[last:]))
+			highlights = append(highlights, &pb.Highlight{Field: f.name, Snippet: sb.String()})
+		}
+	}
+	return highlights
+}
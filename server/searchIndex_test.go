@@ -0,0 +1,79 @@
+package server
+
+import (
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"math/rand"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestHighlightEscapes(t *testing.T) {
+	book := &pb.Book{Title: `Harry <b>Potter</b> & "Friends"`}
+	got := highlight(book, map[string]bool{"potter": true})
+	want := `Harry &lt;b&gt;<em>Potter</em>&lt;/b&gt; &amp; &#34;Friends&#34;`
+	if len(got) != 1 || got[0].Snippet != want {
+		t.Errorf("got %v, want snippet %q", got, want)
+	}
+}
+
+// expandByScan is expand over the whole vocabulary, as a reference
+func expandByScan(idx *searchIndex, q string) map[string]float64 {
+	terms := make(map[string]float64)
+	if _, ok := idx.postings[q]; ok {
+		terms[q] = 1
+	}
+	maxEdits := typoTolerance(q)
+	qLen := utf8.RuneCountInString(q)
+	for term := range idx.postings {
+		if term == q {
+			continue
+		}
+		if qLen >= 2 && strings.HasPrefix(term, q) {
+			terms[term] = prefixMatchWeight
+			continue
+		}
+		if maxEdits == 0 {
+			continue
+		}
+		if dist := editDistance(q, term, maxEdits); dist <= maxEdits {
+			terms[term] = typoMatchWeight / float64(dist)
+		}
+	}
+	return terms
+}
+
+func TestExpandMatchesScan(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	word := func() string {
+		const letters = "abcdeé"
+		runes := []rune(letters)
+		b := make([]rune, 1+rng.Intn(10))
+		for i := range b {
+			b[i] = runes[rng.Intn(len(runes))]
+		}
+		return string(b)
+	}
+
+	idx := newSearchIndex()
+	for id := int32(1); id <= 300; id++ {
+		idx.put(&pb.Book{Id: id, Title: word() + " " + word(), Author: word()})
+	}
+	// Removing books must drop their terms from the vocabulary indexes too
+	for id := int32(1); id <= 300; id += 3 {
+		idx.remove(id)
+	}
+
+	for i := 0; i < 2000; i++ {
+		q := word()
+		got, want := idx.expand(q, false), expandByScan(idx, q)
+		if len(got) != len(want) {
+			t.Fatalf("expand(%q) found %d terms, want %d: %v, want %v", q, len(got), len(want), got, want)
+		}
+		for term, w := range want {
+			if got[term] != w {
+				t.Fatalf("expand(%q)[%q] = %v, want %v", q, term, got[term], w)
+			}
+		}
+	}
+}
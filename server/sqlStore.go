@@ -0,0 +1,482 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io/fs"
+	"log"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// migrations holds the versioned schema changes applied by NewSQLStore.
+// Files are named <version>_<description>.sql and run in version order.
+//
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// SQLStore is a Store backed by a SQL database through database/sql.
+// The schema and queries target SQLite.
+type SQLStore struct {
+	db *sql.DB
+
+	mu       sync.Mutex                 // Held while committing, so observers see commit order
+	observer func(changes []BookChange) // Set by ObserveBooks
+}
+
+// NewSQLStore opens the database and applies any pending migrations
+func NewSQLStore(driverName, dsn string) (*SQLStore, error) {
+	db, err := sql.Open(driverName, dsn)
+	if err != nil {
+		return nil, err
+	}
+	// SQLite allows a single writer; sharing one connection avoids
+	// "database is locked" errors between concurrent transactions
+	db.SetMaxOpenConns(1)
+
+	s := &SQLStore{db: db}
+	if err := s.migrate(context.Background()); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("migrate: %w", err)
+	}
+	return s, nil
+}
+
+// migrate applies the embedded migrations newer than the recorded version
+func (s *SQLStore) migrate(ctx context.Context) error {
+	if _, err := s.db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    INTEGER PRIMARY KEY,
+		applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+	)`); err != nil {
+		return err
+	}
+
+	var current int
+	if err := s.db.QueryRowContext(ctx, `SELECT COALESCE(MAX(version), 0) FROM schema_migrations`).Scan(&current); err != nil {
+		return err
+	}
+
+	names, err := fs.Glob(migrations, "migrations/*.sql")
+	if err != nil {
+		return err
+	}
+	type migration struct {
+		version int
+		name    string
+	}
+	var pending []migration
+	for _, name := range names {
+		base := strings.TrimPrefix(name, "migrations/")
+		version, err := strconv.Atoi(strings.SplitN(base, "_", 2)[0])
+		if err != nil {
+			return fmt.Errorf("invalid migration name %q", base)
+		}
+		if version > current {
+			pending = append(pending, migration{version, name})
+		}
+	}
+	sort.Slice(pending, func(i, j int) bool { return pending[i].version < pending[j].version })
+
+	for _, m := range pending {
+		script, err := migrations.ReadFile(m.name)
+		if err != nil {
+			return err
+		}
+		tx, err := s.db.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, string(script)); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("%s: %w", m.name, err)
+		}
+		if _, err := tx.ExecContext(ctx, `INSERT INTO schema_migrations (version) VALUES (?)`, m.version); err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+		log.Printf("Applied migration %s", m.name)
+	}
+	return nil
+}
+
+// querier is the subset of *sql.DB and *sql.Tx used by sqlTx
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// sqlTx implements Tx on top of a database transaction
+type sqlTx struct {
+	q       querier
+	changes []BookChange // Book changes for the observer
+}
+
+const bookColumns = `id, title, author, isbn, publication_year, genre, revision`
+
+// scanner is implemented by *sql.Row and *sql.Rows
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// scanBook reads a row selected with bookColumns
+func scanBook(row scanner) (*pb.Book, error) {
+	book := &pb.Book{}
+	err := row.Scan(&book.Id, &book.Title, &book.Author, &book.Isbn, &book.PublicationYear, &book.Genre, &book.Revision)
+	return book, err
+}
+
+// checkISBN fails if another book already uses the ISBN of book
+func (tx *sqlTx) checkISBN(ctx context.Context, book *pb.Book) error {
+	if book.Isbn == "" {
+		return nil
+	}
+	var id int32
+	err := tx.q.QueryRowContext(ctx, `SELECT id FROM books WHERE isbn = ? AND id <> ?`, book.Isbn, book.Id).Scan(&id)
+	switch {
+	case err == nil:
+		return ErrDuplicateISBN
+	case errors.Is(err, sql.ErrNoRows):
+		return nil
+	}
+	return err
+}
+
+// CreateBook implementation
+func (tx *sqlTx) CreateBook(ctx context.Context, book *pb.Book) error {
+	if _, err := tx.GetBook(ctx, book.Id); err == nil {
+		return ErrAlreadyExists
+	} else if !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	if err := tx.checkISBN(ctx, book); err != nil {
+		return err
+	}
+	if _, err := tx.q.ExecContext(ctx,
+		`INSERT INTO books (`+bookColumns+`) VALUES (?, ?, ?, ?, ?, ?, 1)`,
+		book.Id, book.Title, book.Author, book.Isbn, book.PublicationYear, book.Genre); err != nil {
+		return err
+	}
+	if err := tx.saveBookLinks(ctx, book); err != nil {
+		return err
+	}
+	book.Revision = 1
+	if err := tx.bumpSequence(ctx, "books", book.Id); err != nil {
+		return err
+	}
+	return tx.recordChange(ctx, nil, book.Id)
+}
+
+// GetBook implementation
+func (tx *sqlTx) GetBook(ctx context.Context, id int32) (*pb.Book, error) {
+	book, err := scanBook(tx.q.QueryRowContext(ctx, `SELECT `+bookColumns+` FROM books WHERE id = ?`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return book, tx.loadBookLinks(ctx, []*pb.Book{book})
+}
+
+// FindBookByISBN implementation
+func (tx *sqlTx) FindBookByISBN(ctx context.Context, isbn string) (*pb.Book, error) {
+	if isbn == "" {
+		return nil, ErrNotFound
+	}
+	book, err := scanBook(tx.q.QueryRowContext(ctx, `SELECT `+bookColumns+` FROM books WHERE isbn = ?`, isbn))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return book, tx.loadBookLinks(ctx, []*pb.Book{book})
+}
+
+// UpdateBook implementation
+func (tx *sqlTx) UpdateBook(ctx context.Context, book *pb.Book) error {
+	prev, err := tx.GetBook(ctx, book.Id)
+	if err != nil {
+		return err
+	}
+	if err := tx.checkISBN(ctx, book); err != nil {
+		return err
+	}
+	err = tx.q.QueryRowContext(ctx,
+		`UPDATE books SET title = ?, author = ?, isbn = ?, publication_year = ?, genre = ?, revision = revision + 1
+		WHERE id = ? RETURNING revision`,
+		book.Title, book.Author, book.Isbn, book.PublicationYear, book.Genre, book.Id).Scan(&book.Revision)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if err := tx.saveBookLinks(ctx, book); err != nil {
+		return err
+	}
+	return tx.recordChange(ctx, prev, book.Id)
+}
+
+// recordChange adds the change of a book from prev to its stored state to
+// the changes reported to the observer
+func (tx *sqlTx) recordChange(ctx context.Context, prev *pb.Book, id int32) error {
+	book, err := tx.GetBook(ctx, id)
+	if err != nil {
+		return err
+	}
+	tx.changes = append(tx.changes, BookChange{Before: prev, After: book})
+	return nil
+}
+
+// DeleteBook implementation
+func (tx *sqlTx) DeleteBook(ctx context.Context, id int32) error {
+	prev, err := tx.GetBook(ctx, id)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.q.ExecContext(ctx, `DELETE FROM books WHERE id = ?`, id); err != nil {
+		return err
+	}
+	if err := tx.saveBookLinks(ctx, &pb.Book{Id: id}); err != nil {
+		return err
+	}
+	tx.changes = append(tx.changes, BookChange{Before: prev})
+	return nil
+}
+
+// saveBookLinks replaces the stored authors and branches of a book
+func (tx *sqlTx) saveBookLinks(ctx context.Context, book *pb.Book) error {
+	if err := tx.saveBookAuthors(ctx, book); err != nil {
+		return err
+	}
+	return tx.saveBookBranches(ctx, book)
+}
+
+// loadBookLinks fills in the authors and branches of books read from the
+// books table
+func (tx *sqlTx) loadBookLinks(ctx context.Context, books []*pb.Book) error {
+	if err := tx.loadBookAuthors(ctx, books); err != nil {
+		return err
+	}
+	return tx.loadBookBranches(ctx, books)
+}
+
+// booksByID indexes books by ID and returns the lowest and highest ID, so
+// that their links can be read with a range query rather than a full scan
+func booksByID(books []*pb.Book) (byID map[int32]*pb.Book, first, last int32) {
+	byID = make(map[int32]*pb.Book, len(books))
+	first, last = books[0].Id, books[0].Id
+	for _, book := range books {
+		byID[book.Id] = book
+		first, last = min(first, book.Id), max(last, book.Id)
+	}
+	return byID, first, last
+}
+
+// ListBooks implementation
+func (tx *sqlTx) ListBooks(ctx context.Context) ([]*pb.Book, error) {
+	return tx.queryBooks(ctx, `SELECT `+bookColumns+` FROM books ORDER BY id`)
+}
+
+// ListBooksAfter implementation
+func (tx *sqlTx) ListBooksAfter(ctx context.Context, afterID int32, limit int) ([]*pb.Book, error) {
+	return tx.queryBooks(ctx, `SELECT `+bookColumns+` FROM books WHERE id > ? ORDER BY id LIMIT ?`, afterID, limit)
+}
+
+// queryBooks runs a query selecting bookColumns and returns the books with
+// their author links
+func (tx *sqlTx) queryBooks(ctx context.Context, query string, args ...any) ([]*pb.Book, error) {
+	rows, err := tx.q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var books []*pb.Book
+	for rows.Next() {
+		book, err := scanBook(rows)
+		if err != nil {
+			return nil, err
+		}
+		books = append(books, book)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+	return books, tx.loadBookLinks(ctx, books)
+}
+
+// NextBookID implementation
+func (tx *sqlTx) NextBookID(ctx context.Context) (int32, error) {
+	return tx.nextID(ctx, "books", "books")
+}
+
+// nextID allocates the next value of a sequence, keeping it above every ID
+// in table. Sequence and table names are constants, never client input.
+func (tx *sqlTx) nextID(ctx context.Context, sequence, table string) (int32, error) {
+	var id int64
+	err := tx.q.QueryRowContext(ctx,
+		`UPDATE sequences SET value = MAX(value, (SELECT COALESCE(MAX(id), 0) FROM `+table+`)) + 1
+		WHERE name = ? RETURNING value`, sequence).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	if id > math.MaxInt32 {
+		return 0, ErrIDsExhausted
+	}
+	return int32(id), nil
+}
+
+// unixSeconds is the stored form of an optional timestamp
+func unixSeconds(ts *timestamppb.Timestamp) sql.NullInt64 {
+	if ts == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: ts.Seconds, Valid: true}
+}
+
+// timestampOf converts a timestamp stored with unixSeconds back
+func timestampOf(n sql.NullInt64) *timestamppb.Timestamp {
+	if !n.Valid {
+		return nil
+	}
+	return &timestamppb.Timestamp{Seconds: n.Int64}
+}
+
+// bumpSequence keeps a sequence ahead of a client-chosen ID
+func (tx *sqlTx) bumpSequence(ctx context.Context, sequence string, id int32) error {
+	_, err := tx.q.ExecContext(ctx, `UPDATE sequences SET value = MAX(value, ?) WHERE name = ?`, id, sequence)
+	return err
+}
+
+// checkAffected maps an update or delete that matched no row to ErrNotFound
+func checkAffected(res sql.Result) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Savepoint implementation. SQLite savepoints nest, so one name serves
+// every level.
+func (tx *sqlTx) Savepoint(ctx context.Context, fn func() error) error {
+	if _, err := tx.q.ExecContext(ctx, `SAVEPOINT item`); err != nil {
+		return err
+	}
+	changes := len(tx.changes)
+	if err := fn(); err != nil {
+		tx.changes = tx.changes[:changes]
+		if _, rerr := tx.q.ExecContext(ctx, `ROLLBACK TO item`); rerr != nil {
+			return fmt.Errorf("roll back to savepoint: %w", rerr)
+		}
+		if _, rerr := tx.q.ExecContext(ctx, `RELEASE item`); rerr != nil {
+			return fmt.Errorf("release savepoint: %w", rerr)
+		}
+		return err
+	}
+	_, err := tx.q.ExecContext(ctx, `RELEASE item`)
+	return err
+}
+
+// RunInTx runs fn in a database transaction and commits if it succeeds
+func (s *SQLStore) RunInTx(ctx context.Context, fn func(tx Tx) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	stx := &sqlTx{q: tx}
+	if err := fn(stx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	if s.observer != nil && len(stx.changes) > 0 {
+		s.observer(stx.changes)
+	}
+	return nil
+}
+
+// ObserveBooks implementation
+func (s *SQLStore) ObserveBooks(fn func(changes []BookChange)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.observer = fn
+}
+
+// NextFeedEpoch implementation, keeping the last number returned in the
+// sequences table
+func (s *SQLStore) NextFeedEpoch(ctx context.Context, atLeast int64) (epoch int64, err error) {
+	err = s.db.QueryRowContext(ctx,
+		`INSERT INTO sequences (name, value) VALUES ('feed_epochs', ?)
+		ON CONFLICT (name) DO UPDATE SET value = MAX(value + 1, excluded.value)
+		RETURNING value`, atLeast).Scan(&epoch)
+	return epoch, err
+}
+
+// CreateBook implementation
+func (s *SQLStore) CreateBook(ctx context.Context, book *pb.Book) error {
+	return s.RunInTx(ctx, func(tx Tx) error { return tx.CreateBook(ctx, book) })
+}
+
+// GetBook implementation
+func (s *SQLStore) GetBook(ctx context.Context, id int32) (*pb.Book, error) {
+	return (&sqlTx{q: s.db}).GetBook(ctx, id)
+}
+
+// FindBookByISBN implementation
+func (s *SQLStore) FindBookByISBN(ctx context.Context, isbn string) (*pb.Book, error) {
+	return (&sqlTx{q: s.db}).FindBookByISBN(ctx, isbn)
+}
+
+// UpdateBook implementation
+func (s *SQLStore) UpdateBook(ctx context.Context, book *pb.Book) error {
+	return s.RunInTx(ctx, func(tx Tx) error { return tx.UpdateBook(ctx, book) })
+}
+
+// NextBookID implementation
+func (s *SQLStore) NextBookID(ctx context.Context) (int32, error) {
+	return (&sqlTx{q: s.db}).NextBookID(ctx)
+}
+
+// DeleteBook implementation
+func (s *SQLStore) DeleteBook(ctx context.Context, id int32) error {
+	return s.RunInTx(ctx, func(tx Tx) error { return tx.DeleteBook(ctx, id) })
+}
+
+// ListBooks implementation
+func (s *SQLStore) ListBooks(ctx context.Context) ([]*pb.Book, error) {
+	return (&sqlTx{q: s.db}).ListBooks(ctx)
+}
+
+// ListBooksAfter implementation
+func (s *SQLStore) ListBooksAfter(ctx context.Context, afterID int32, limit int) ([]*pb.Book, error) {
+	return (&sqlTx{q: s.db}).ListBooksAfter(ctx, afterID, limit)
+}
+
+// Close closes the database
+func (s *SQLStore) Close() error {
+	return s.db.Close()
+}
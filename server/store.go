@@ -0,0 +1,215 @@
+package server
+
+import (
+	"context"
+	"errors"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+)
+
+// Errors returned by Store implementations
+var (
+	ErrNotFound         = errors.New("not found")
+	ErrAlreadyExists    = errors.New("already exists")
+	ErrIDsExhausted     = errors.New("no more IDs available")
+	ErrDuplicateISBN    = errors.New("isbn already in use by another book")
+	ErrRevisionMismatch = errors.New("revision does not match the stored one")
+	ErrDuplicateBarcode = errors.New("barcode already in use by another copy")
+	ErrBookHasCopies    = errors.New("book still has copies that are not retired")
+	ErrDuplicateBranch  = errors.New("code already in use by another branch")
+)
+
+// BookChange is a change made to a book by a committed transaction. Before
+// is nil for a created book and After for a deleted one.
+type BookChange struct {
+	Before, After *pb.Book
+}
+
+// BookTx is the set of book operations available inside a transaction.
+// CreateBook and UpdateBook set book.Revision to the revision they stored:
+// 1 for a new book, one more than the previous revision for an update.
+type BookTx interface {
+	CreateBook(ctx context.Context, book *pb.Book) error
+	GetBook(ctx context.Context, id int32) (*pb.Book, error)
+	FindBookByISBN(ctx context.Context, isbn string) (*pb.Book, error)
+	UpdateBook(ctx context.Context, book *pb.Book) error
+	DeleteBook(ctx context.Context, id int32) error
+	ListBooks(ctx context.Context) ([]*pb.Book, error)
+
+	// ListBooksAfter returns up to limit books with an ID greater than
+	// afterID, ordered by ID, so that callers can walk the catalog in pages
+	ListBooksAfter(ctx context.Context, afterID int32, limit int) ([]*pb.Book, error)
+
+	// NextBookID allocates an ID greater than any book ID stored so far,
+	// including deleted ones
+	NextBookID(ctx context.Context) (int32, error)
+}
+
+// AuthorTx is the set of author operations available inside a transaction.
+// Books link to authors through Book.authors. Revisions and IDs behave as
+// for books.
+type AuthorTx interface {
+	CreateAuthor(ctx context.Context, author *pb.Author) error
+	GetAuthor(ctx context.Context, id int32) (*pb.Author, error)
+	UpdateAuthor(ctx context.Context, author *pb.Author) error
+	DeleteAuthor(ctx context.Context, id int32) error
+	ListAuthors(ctx context.Context) ([]*pb.Author, error)
+	NextAuthorID(ctx context.Context) (int32, error)
+
+	// ListBooksByAuthor returns the books linked to an author ordered by ID
+	ListBooksByAuthor(ctx context.Context, authorID int32) ([]*pb.Book, error)
+}
+
+// TransferFilter selects transfers in BranchTx.ListTransfers. Zero fields
+// match any transfer.
+type TransferFilter struct {
+	BookID   int32
+	BranchID int32 // Source or destination of the transfer
+}
+
+// BranchTx is the set of branch operations available inside a transaction,
+// along with the audit records of book transfers, which are only ever
+// added. Books are scoped to branches through Book.branch_ids and copies
+// name the code of their branch, which is unique. Revisions and IDs behave
+// as for books.
+type BranchTx interface {
+	CreateBranch(ctx context.Context, branch *pb.Branch) error
+	GetBranch(ctx context.Context, id int32) (*pb.Branch, error)
+	UpdateBranch(ctx context.Context, branch *pb.Branch) error
+	DeleteBranch(ctx context.Context, id int32) error
+	ListBranches(ctx context.Context) ([]*pb.Branch, error)
+	NextBranchID(ctx context.Context) (int32, error)
+
+	CreateTransfer(ctx context.Context, transfer *pb.BookTransfer) error
+
+	// ListTransfers returns the transfers matching filter ordered by ID
+	ListTransfers(ctx context.Context, filter TransferFilter) ([]*pb.BookTransfer, error)
+	NextTransferID(ctx context.Context) (int32, error)
+}
+
+// MemberTx is the set of member operations available inside a transaction.
+// Revisions and IDs behave as for books.
+type MemberTx interface {
+	CreateMember(ctx context.Context, member *pb.Member) error
+	GetMember(ctx context.Context, id int32) (*pb.Member, error)
+	UpdateMember(ctx context.Context, member *pb.Member) error
+	DeleteMember(ctx context.Context, id int32) error
+	ListMembers(ctx context.Context) ([]*pb.Member, error)
+	NextMemberID(ctx context.Context) (int32, error)
+}
+
+// CopyTx is the set of copy operations available inside a transaction.
+// Copies are retired rather than deleted. Revisions and IDs behave as for
+// books.
+type CopyTx interface {
+	CreateCopy(ctx context.Context, c *pb.Copy) error
+	GetCopy(ctx context.Context, id int32) (*pb.Copy, error)
+	UpdateCopy(ctx context.Context, c *pb.Copy) error
+
+	// ListCopies returns the copies of a book, or of every book if bookID
+	// is zero, ordered by ID
+	ListCopies(ctx context.Context, bookID int32) ([]*pb.Copy, error)
+	NextCopyID(ctx context.Context) (int32, error)
+}
+
+// LoanFilter selects loans in LoanTx.ListLoans. Zero fields match any loan.
+type LoanFilter struct {
+	MemberID int32
+	BookID   int32
+	CopyID   int32
+	OpenOnly bool // Only loans that have not been returned
+}
+
+// LoanTx is the set of loan operations available inside a transaction.
+// Loans are kept after they are returned. Revisions and IDs behave as for
+// books.
+type LoanTx interface {
+	CreateLoan(ctx context.Context, loan *pb.Loan) error
+	GetLoan(ctx context.Context, id int32) (*pb.Loan, error)
+	UpdateLoan(ctx context.Context, loan *pb.Loan) error
+
+	// ListLoans returns the loans matching filter ordered by ID
+	ListLoans(ctx context.Context, filter LoanFilter) ([]*pb.Loan, error)
+	NextLoanID(ctx context.Context) (int32, error)
+}
+
+// HoldFilter selects holds in HoldTx.ListHolds. Zero fields match any hold.
+type HoldFilter struct {
+	BookID     int32
+	MemberID   int32
+	ActiveOnly bool // Only holds that are WAITING or READY
+}
+
+// HoldTx is the set of hold operations available inside a transaction.
+// Holds are kept after they are closed. Revisions and IDs behave as for
+// books.
+type HoldTx interface {
+	CreateHold(ctx context.Context, hold *pb.Hold) error
+	GetHold(ctx context.Context, id int32) (*pb.Hold, error)
+	UpdateHold(ctx context.Context, hold *pb.Hold) error
+
+	// ListHolds returns the holds matching filter ordered by ID, which is
+	// the order they were placed in
+	ListHolds(ctx context.Context, filter HoldFilter) ([]*pb.Hold, error)
+	NextHoldID(ctx context.Context) (int32, error)
+}
+
+// LedgerFilter selects entries in LedgerTx.ListLedgerEntries. Zero fields
+// match any entry.
+type LedgerFilter struct {
+	MemberID  int32
+	LoanID    int32
+	RelatedID int32
+}
+
+// LedgerTx is the set of fee ledger operations available inside a
+// transaction. Entries are only ever added, never changed or deleted.
+type LedgerTx interface {
+	CreateLedgerEntry(ctx context.Context, entry *pb.LedgerEntry) error
+	GetLedgerEntry(ctx context.Context, id int32) (*pb.LedgerEntry, error)
+
+	// ListLedgerEntries returns the entries matching filter ordered by ID,
+	// which is the order they were recorded in
+	ListLedgerEntries(ctx context.Context, filter LedgerFilter) ([]*pb.LedgerEntry, error)
+	NextLedgerEntryID(ctx context.Context) (int32, error)
+}
+
+// Tx is the set of operations available inside a transaction
+type Tx interface {
+	BookTx
+	AuthorTx
+	BranchTx
+	MemberTx
+	CopyTx
+	LoanTx
+	HoldTx
+	LedgerTx
+
+	// Savepoint runs fn and, if it fails, undoes the changes fn made
+	// through the transaction while keeping the earlier ones
+	Savepoint(ctx context.Context, fn func() error) error
+}
+
+// Store is the storage backend behind the services.
+// The embedded BookTx methods run as single-operation transactions; other
+// records are reached through RunInTx.
+type Store interface {
+	BookTx
+
+	// RunInTx runs fn in a transaction. The changes made through tx are
+	// applied atomically if fn returns nil and discarded otherwise.
+	RunInTx(ctx context.Context, fn func(tx Tx) error) error
+
+	// ObserveBooks makes the store call fn with the book changes of every
+	// transaction that commits, in commit order and before the next
+	// transaction commits. fn runs with the store locked, so it must return
+	// quickly and must not use the store or modify the books.
+	ObserveBooks(fn func(changes []BookChange))
+
+	// NextFeedEpoch returns a number for a new incarnation of the book
+	// change feed, at least atLeast and greater than any number returned
+	// before, including by earlier runs of a durable store
+	NextFeedEpoch(ctx context.Context, atLeast int64) (int64, error)
+
+	// Close releases the resources held by the store
+	Close() error
+}
@@ -0,0 +1,226 @@
+package server
+
+import (
+	"context"
+	"errors"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"io/fs"
+	_ "modernc.org/sqlite"
+	"path/filepath"
+	"testing"
+)
+
+// testStores creates an empty store of every kind, closed when the test ends
+var testStores = []struct {
+	name string
+	open func(t *testing.T) (Store, error)
+}{
+	{"memory", func(t *testing.T) (Store, error) { return NewBookStore(), nil }},
+	{"file", func(t *testing.T) (Store, error) { return NewFileStore(t.TempDir(), 0) }},
+	{"sqlite", func(t *testing.T) (Store, error) { return NewSQLStore("sqlite", ":memory:") }},
+}
+
+// forEachStore runs fn as a subtest against an empty store of every kind
+func forEachStore(t *testing.T, fn func(t *testing.T, s Store)) {
+	for _, ts := range testStores {
+		t.Run(ts.name, func(t *testing.T) {
+			s, err := ts.open(t)
+			if err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() { s.Close() })
+			fn(t, s)
+		})
+	}
+}
+
+func TestStoreBooks(t *testing.T) {
+	ctx := context.Background()
+	forEachStore(t, func(t *testing.T, s Store) {
+		dune := &pb.Book{Id: 1, Title: "Dune", Isbn: "9780441013593"}
+		tests := []struct {
+			name string
+			op   func() error
+			want error
+		}{
+			{"create", func() error { return s.CreateBook(ctx, dune) }, nil},
+			{"create with a taken ID", func() error { return s.CreateBook(ctx, &pb.Book{Id: 1, Title: "Emma"}) }, ErrAlreadyExists},
+			{"create with a taken ISBN", func() error {
+				return s.CreateBook(ctx, &pb.Book{Id: 2, Title: "Emma", Isbn: dune.Isbn})
+			}, ErrDuplicateISBN},
+			{"create without an ISBN", func() error { return s.CreateBook(ctx, &pb.Book{Id: 2, Title: "Emma"}) }, nil},
+			{"create another without an ISBN", func() error { return s.CreateBook(ctx, &pb.Book{Id: 3, Title: "Ulysses"}) }, nil},
+			{"update to a taken ISBN", func() error {
+				return s.UpdateBook(ctx, &pb.Book{Id: 2, Title: "Emma", Isbn: dune.Isbn})
+			}, ErrDuplicateISBN},
+			{"update keeping its ISBN", func() error { return s.UpdateBook(ctx, &pb.Book{Id: 1, Title: "Dune", Isbn: dune.Isbn}) }, nil},
+			{"update a missing book", func() error { return s.UpdateBook(ctx, &pb.Book{Id: 9, Title: "Lost"}) }, ErrNotFound},
+			{"delete", func() error { return s.DeleteBook(ctx, 3) }, nil},
+			{"delete a missing book", func() error { return s.DeleteBook(ctx, 3) }, ErrNotFound},
+		}
+		for _, tc := range tests {
+			if err := tc.op(); !errors.Is(err, tc.want) {
+				t.Errorf("%s: got %v, want %v", tc.name, err, tc.want)
+			}
+		}
+
+		got, err := s.FindBookByISBN(ctx, dune.Isbn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Id != 1 || got.Revision != 2 {
+			t.Errorf("book by ISBN: ID %d revision %d, want ID 1 revision 2", got.Id, got.Revision)
+		}
+		if _, err := s.GetBook(ctx, 3); !errors.Is(err, ErrNotFound) {
+			t.Errorf("deleted book: got %v, want %v", err, ErrNotFound)
+		}
+		books, err := s.ListBooks(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(books) != 2 || books[0].Id != 1 || books[1].Id != 2 {
+			t.Errorf("got books %v, want 1 and 2", books)
+		}
+		page, err := s.ListBooksAfter(ctx, 1, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(page) != 1 || page[0].Id != 2 {
+			t.Errorf("got books after 1 %v, want 2", page)
+		}
+	})
+}
+
+func TestStoreRevisions(t *testing.T) {
+	ctx := context.Background()
+	forEachStore(t, func(t *testing.T, s Store) {
+		book := &pb.Book{Id: 1, Title: "Dune", Revision: 7}
+		if err := s.CreateBook(ctx, book); err != nil {
+			t.Fatal(err)
+		}
+		if book.Revision != 1 {
+			t.Errorf("created revision %d, want 1", book.Revision)
+		}
+		for want := int64(2); want <= 3; want++ {
+			book.Revision = 0
+			if err := s.UpdateBook(ctx, book); err != nil {
+				t.Fatal(err)
+			}
+			stored, err := s.GetBook(ctx, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if book.Revision != want || stored.Revision != want {
+				t.Errorf("update set revision %d and stored %d, want %d", book.Revision, stored.Revision, want)
+			}
+		}
+	})
+}
+
+func TestStoreSequences(t *testing.T) {
+	ctx := context.Background()
+	forEachStore(t, func(t *testing.T, s Store) {
+		next := func(want int32) {
+			t.Helper()
+			if id, err := s.NextBookID(ctx); err != nil || id != want {
+				t.Errorf("next book ID: got %d, %v, want %d", id, err, want)
+			}
+		}
+		next(1)
+		next(2)
+
+		// Client-chosen IDs move the sequence past them, and deleting the
+		// highest book does not free its ID
+		if err := s.CreateBook(ctx, &pb.Book{Id: 10, Title: "Dune"}); err != nil {
+			t.Fatal(err)
+		}
+		next(11)
+		if err := s.DeleteBook(ctx, 10); err != nil {
+			t.Fatal(err)
+		}
+		next(12)
+
+		// Other records have sequences of their own
+		err := s.RunInTx(ctx, func(tx Tx) error {
+			id, err := tx.NextMemberID(ctx)
+			if id != 1 {
+				t.Errorf("first member ID %d, want 1", id)
+			}
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestStoreRollback(t *testing.T) {
+	ctx := context.Background()
+	forEachStore(t, func(t *testing.T, s Store) {
+		if err := s.CreateBook(ctx, &pb.Book{Id: 1, Title: "Dune"}); err != nil {
+			t.Fatal(err)
+		}
+		errFail := errors.New("fail")
+		err := s.RunInTx(ctx, func(tx Tx) error {
+			id, err := tx.NextBookID(ctx)
+			if err != nil {
+				return err
+			}
+			if err := tx.CreateBook(ctx, &pb.Book{Id: id, Title: "Emma"}); err != nil {
+				return err
+			}
+			if err := tx.UpdateBook(ctx, &pb.Book{Id: 1, Title: "Dune Messiah"}); err != nil {
+				return err
+			}
+			if err := tx.CreateMember(ctx, &pb.Member{Id: 1, Name: "Ada"}); err != nil {
+				return err
+			}
+			return errFail
+		})
+		if !errors.Is(err, errFail) {
+			t.Fatalf("got %v, want %v", err, errFail)
+		}
+
+		books, err := s.ListBooks(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(books) != 1 || books[0].Title != "Dune" || books[0].Revision != 1 {
+			t.Errorf("books after a rollback: %v", books)
+		}
+		if id, err := s.NextBookID(ctx); err != nil || id != 2 {
+			t.Errorf("next book ID after a rollback: got %d, %v, want 2", id, err)
+		}
+		err = s.RunInTx(ctx, func(tx Tx) error {
+			_, err := tx.GetMember(ctx, 1)
+			return err
+		})
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("member after a rollback: got %v, want %v", err, ErrNotFound)
+		}
+	})
+}
+
+func TestSQLStoreMigrations(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "library.db")
+	names, err := fs.Glob(migrations, "migrations/*.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Reopening applies nothing more
+	for i := 0; i < 2; i++ {
+		s, err := NewSQLStore("sqlite", path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var version, applied int
+		err = s.db.QueryRow(`SELECT MAX(version), COUNT(*) FROM schema_migrations`).Scan(&version, &applied)
+		s.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if version != len(names) || applied != len(names) {
+			t.Errorf("open %d: version %d with %d migrations applied, want %d", i, version, applied, len(names))
+		}
+	}
+}
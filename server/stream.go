@@ -0,0 +1,99 @@
+package server
+
+import (
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"strings"
+)
+
+// Chunk limits for StreamBooks. Chunks are also cut once they reach
+// maxChunkBytes so that they stay well below the gRPC message size limit
+// however large the books are. The store is read streamPageSize books at a
+// time, independently of the chunk size since filters may drop most books.
+const (
+	defaultChunkSize = 100
+	maxChunkSize     = 1000
+	maxChunkBytes    = 1 << 20
+	streamPageSize   = 500
+)
+
+// StreamBooks implementation. Books are read from the store a page at a
+// time in ID order; Send blocks while the client's flow control window is
+// full, so a slow reader holds back the stream rather than making it buffer
+// the catalog.
+func (s *LibraryServer) StreamBooks(req *pb.StreamBooksRequest, stream grpc.ServerStreamingServer[pb.StreamBooksResponse]) error {
+	ctx := stream.Context()
+	chunkSize, err := resolvePageSize("chunk_size", req.ChunkSize, defaultChunkSize, maxChunkSize)
+	if err != nil {
+		return err
+	}
+	if req.MinYear != 0 && req.MaxYear != 0 && req.MinYear > req.MaxYear {
+		return fieldError(codes.InvalidArgument, "max_year", "must not be before min_year")
+	}
+
+	chunk := &pb.StreamBooksResponse{}
+	size := 0
+	flush := func() error {
+		if len(chunk.Books) == 0 {
+			return nil
+		}
+		if err := stream.Send(chunk); err != nil {
+			return err
+		}
+		chunk = &pb.StreamBooksResponse{}
+		size = 0
+		return nil
+	}
+	var after int32
+	for {
+		if err := ctx.Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+		books, err := s.store.ListBooksAfter(ctx, after, streamPageSize)
+		if err != nil {
+			return toStatus(err, bookResourceType, "books")
+		}
+		if len(books) == 0 {
+			return flush()
+		}
+		after = books[len(books)-1].Id
+
+		for _, book := range books {
+			if !matchesStreamFilter(req, book) {
+				continue
+			}
+			n := proto.Size(book)
+			if len(chunk.Books) > 0 && size+n > maxChunkBytes {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+			chunk.Books = append(chunk.Books, book)
+			size += n
+			if len(chunk.Books) == chunkSize {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// matchesStreamFilter reports whether a book passes the filters of a
+// StreamBooks request. Unset filters match every book.
+func matchesStreamFilter(req *pb.StreamBooksRequest, book *pb.Book) bool {
+	switch {
+	case req.Author != "" && !strings.EqualFold(book.Author, req.Author):
+		return false
+	case req.Genre != "" && !strings.EqualFold(book.Genre, req.Genre):
+		return false
+	case req.MinYear != 0 && book.PublicationYear < req.MinYear:
+		return false
+	case req.MaxYear != 0 && book.PublicationYear > req.MaxYear:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,99 @@
+package server
+
+import (
+	"context"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+// fakeBookStream collects the chunks sent by StreamBooks. onSend, when set,
+// is called after every chunk.
+type fakeBookStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	chunks []*pb.StreamBooksResponse
+	onSend func()
+}
+
+func (f *fakeBookStream) Context() context.Context { return f.ctx }
+
+func (f *fakeBookStream) Send(chunk *pb.StreamBooksResponse) error {
+	f.chunks = append(f.chunks, chunk)
+	if f.onSend != nil {
+		f.onSend()
+	}
+	return nil
+}
+
+func TestStreamBooksPages(t *testing.T) {
+	ctx := context.Background()
+	store := NewBookStore()
+	const total = 3*streamPageSize + 17
+	var want []int32
+	err := store.RunInTx(ctx, func(tx Tx) error {
+		for id := int32(1); id <= total; id++ {
+			if err := tx.CreateBook(ctx, &pb.Book{Id: id, Title: "Book"}); err != nil {
+				return err
+			}
+		}
+		// Gaps in the IDs must not end or repeat a page
+		for id := int32(1); id <= total; id++ {
+			if id%7 == 0 {
+				if err := tx.DeleteBook(ctx, id); err != nil {
+					return err
+				}
+			} else {
+				want = append(want, id)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewLibraryServer(store, DefaultWatchHistory)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stream := &fakeBookStream{ctx: ctx}
+	if err := s.StreamBooks(&pb.StreamBooksRequest{ChunkSize: 64}, stream); err != nil {
+		t.Fatal(err)
+	}
+	var got []int32
+	for _, chunk := range stream.chunks {
+		if len(chunk.Books) > 64 {
+			t.Errorf("chunk of %d books, want at most 64", len(chunk.Books))
+		}
+		for _, book := range chunk.Books {
+			got = append(got, book.Id)
+		}
+	}
+	if len(got) != len(want) {
+		t.Fatalf("streamed %d books, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("book %d: got ID %d, want %d", i, got[i], want[i])
+		}
+	}
+
+	if err := s.StreamBooks(&pb.StreamBooksRequest{ChunkSize: -1}, &fakeBookStream{ctx: ctx}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("negative chunk size: got %v, want InvalidArgument", err)
+	}
+
+	// A cancelled stream stops before the next page is read
+	cctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream = &fakeBookStream{ctx: cctx, onSend: cancel}
+	err = s.StreamBooks(&pb.StreamBooksRequest{ChunkSize: streamPageSize}, stream)
+	if status.Code(err) != codes.Canceled {
+		t.Errorf("cancelled stream: got %v, want Canceled", err)
+	}
+	if len(stream.chunks) != 1 {
+		t.Errorf("cancelled stream sent %d chunks, want 1", len(stream.chunks))
+	}
+}
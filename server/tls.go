@@ -0,0 +1,146 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// TLSConfig selects the certificates of the gRPC listener
+type TLSConfig struct {
+	CertFile string // PEM certificate chain of the server
+	KeyFile  string // PEM private key of the server
+
+	// ClientCAFile holds the PEM certificates of the authorities that sign
+	// client certificates. If set, clients must present a certificate signed
+	// by one of them, unless ClientCertOptional is set.
+	ClientCAFile       string
+	ClientCertOptional bool
+}
+
+// loadCertPool reads a PEM bundle of CA certificates
+func loadCertPool(path string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("%s: no PEM certificates found", path)
+	}
+	return pool, nil
+}
+
+// certReloader serves the server certificate and client CAs of a TLSConfig,
+// rereading the files every few seconds when they changed so that renewed
+// certificates are picked up without a restart. Files that fail to load are
+// logged and the previous certificates are kept.
+type certReloader struct {
+	cfg TLSConfig
+	now func() time.Time
+
+	mu        sync.Mutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+	files     []*watchedFile
+}
+
+// load reads all the files of the configuration
+func (r *certReloader) load() error {
+	cert, err := tls.LoadX509KeyPair(r.cfg.CertFile, r.cfg.KeyFile)
+	if err != nil {
+		return err
+	}
+	var clientCAs *x509.CertPool
+	if r.cfg.ClientCAFile != "" {
+		if clientCAs, err = loadCertPool(r.cfg.ClientCAFile); err != nil {
+			return err
+		}
+	}
+	r.cert, r.clientCAs = &cert, clientCAs
+	return nil
+}
+
+// current returns the certificates in use, first rereading them if any file
+// changed on disk. The changes only count as seen once a reload succeeds, so
+// a certificate caught half-written is retried at the next check.
+func (r *certReloader) current() (*tls.Certificate, *x509.CertPool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.now()
+	var changed []*watchedFile
+	for _, f := range r.files {
+		if f.changed(now) {
+			changed = append(changed, f)
+		}
+	}
+	if len(changed) > 0 {
+		if err := r.load(); err != nil {
+			log.Printf("Failed to reload the TLS certificates, keeping the previous ones: %v", err)
+		} else {
+			for _, f := range changed {
+				f.loaded()
+			}
+			log.Printf("TLS certificates reloaded from %s", r.cfg.CertFile)
+		}
+	}
+	return r.cert, r.clientCAs
+}
+
+// newCertReloader loads the certificates of cfg and starts watching their
+// files
+func newCertReloader(cfg TLSConfig) (*certReloader, error) {
+	r := &certReloader{cfg: cfg, now: time.Now}
+	for _, path := range []string{cfg.CertFile, cfg.KeyFile, cfg.ClientCAFile} {
+		if path == "" {
+			continue
+		}
+		f := &watchedFile{path: path, checked: r.now()}
+		if info, err := os.Stat(path); err == nil {
+			f.modTime = info.ModTime()
+		}
+		r.files = append(r.files, f)
+	}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// NewServerTLSConfig returns the TLS configuration of the gRPC listener.
+// The certificate and client CAs are reloaded when their files change.
+func NewServerTLSConfig(cfg TLSConfig) (*tls.Config, error) {
+	if cfg.CertFile == "" || cfg.KeyFile == "" {
+		return nil, errors.New("both a certificate and a key file are required")
+	}
+	r, err := newCertReloader(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	clientAuth := tls.NoClientCert
+	switch {
+	case cfg.ClientCAFile != "" && cfg.ClientCertOptional:
+		clientAuth = tls.VerifyClientCertIfGiven
+	case cfg.ClientCAFile != "":
+		clientAuth = tls.RequireAndVerifyClientCert
+	}
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, clientCAs := r.current()
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+				ClientAuth:   clientAuth,
+				ClientCAs:    clientCAs,
+				NextProtos:   []string{"h2"},
+			}, nil
+		},
+	}, nil
+}
@@ -0,0 +1,92 @@
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// newTestCert returns a PEM self-signed certificate and its PEM key
+func newTestCert(t *testing.T, name string) (certPEM, keyPEM []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestCertReloaderRetriesFailedReload(t *testing.T) {
+	dir := t.TempDir()
+	cfg := TLSConfig{CertFile: filepath.Join(dir, "cert.pem"), KeyFile: filepath.Join(dir, "key.pem")}
+	write := func(path string, data []byte, modTime time.Time) {
+		t.Helper()
+		if err := os.WriteFile(path, data, 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	start := time.Now().Truncate(time.Second)
+	oldCert, oldKey := newTestCert(t, "old")
+	write(cfg.CertFile, oldCert, start)
+	write(cfg.KeyFile, oldKey, start)
+
+	r, err := newCertReloader(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	r.now = func() time.Time { return now }
+	subject := func() string {
+		t.Helper()
+		cert, _ := r.current()
+		leaf, err := x509.ParseCertificate(cert.Certificate[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		return leaf.Subject.CommonName
+	}
+
+	// The new key is written before the new certificate, so the first
+	// reload finds them mismatched
+	newCert, newKey := newTestCert(t, "new")
+	write(cfg.KeyFile, newKey, start.Add(time.Second))
+	now = now.Add(reloadCheckInterval)
+	if got := subject(); got != "old" {
+		t.Errorf("after a failed reload: serving %q, want old", got)
+	}
+
+	// The certificate is replaced without changing its modification time,
+	// as can happen on file systems with coarse timestamps, yet the failed
+	// reload is retried
+	write(cfg.CertFile, newCert, start)
+	now = now.Add(reloadCheckInterval)
+	if got := subject(); got != "new" {
+		t.Errorf("after retrying the reload: serving %q, want new", got)
+	}
+}
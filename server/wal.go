@@ -0,0 +1,160 @@
+package server
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"hash/crc32"
+	"io"
+)
+
+// Records are framed as a 4-byte payload length and a 4-byte CRC-32C of the
+// payload, both little endian, followed by the payload itself. The payload
+// is the list of mutations of one transaction, each encoded as
+// op, kind, uvarint message length, message bytes.
+const (
+	recordHeaderSize = 8
+	maxRecordSize    = 64 << 20
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// Errors reported by readRecords
+var (
+	// errTornRecord reports a final record that was only partially written
+	errTornRecord = errors.New("torn record")
+
+	// errCorruptRecord reports a record whose checksum or content is wrong
+	// and that is followed by more data, so it cannot be a torn append
+	errCorruptRecord = errors.New("corrupt record")
+)
+
+// newMessage returns an empty message for the given record kind
+func newMessage(kind byte) (proto.Message, error) {
+	switch kind {
+	case kindBook:
+		return &pb.Book{}, nil
+	case kindMember:
+		return &pb.Member{}, nil
+	case kindCopy:
+		return &pb.Copy{}, nil
+	case kindLoan:
+		return &pb.Loan{}, nil
+	case kindHold:
+		return &pb.Hold{}, nil
+	case kindLedgerEntry:
+		return &pb.LedgerEntry{}, nil
+	case kindAuthor:
+		return &pb.Author{}, nil
+	case kindBranch:
+		return &pb.Branch{}, nil
+	case kindTransfer:
+		return &pb.BookTransfer{}, nil
+	case kindBookSequence, kindMemberSequence, kindCopySequence, kindLoanSequence, kindHoldSequence,
+		kindLedgerEntrySequence, kindAuthorSequence, kindBranchSequence, kindTransferSequence:
+		return &wrapperspb.Int32Value{}, nil
+	}
+	return nil, fmt.Errorf("unknown record kind %d", kind)
+}
+
+// encodeRecord frames the mutations of one transaction
+func encodeRecord(muts []mutation) ([]byte, error) {
+	buf := make([]byte, recordHeaderSize)
+	for _, m := range muts {
+		data, err := proto.Marshal(m.msg)
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, m.op, m.kind)
+		buf = binary.AppendUvarint(buf, uint64(len(data)))
+		buf = append(buf, data...)
+	}
+	payload := buf[recordHeaderSize:]
+	binary.LittleEndian.PutUint32(buf[0:4], uint32(len(payload)))
+	binary.LittleEndian.PutUint32(buf[4:8], crc32.Checksum(payload, crcTable))
+	return buf, nil
+}
+
+// decodeRecord parses the payload of a record
+func decodeRecord(payload []byte) ([]mutation, error) {
+	var muts []mutation
+	for len(payload) > 0 {
+		if len(payload) < 2 {
+			return nil, errCorruptRecord
+		}
+		op, kind := payload[0], payload[1]
+		size, n := binary.Uvarint(payload[2:])
+		if n <= 0 || uint64(len(payload)-2-n) < size {
+			return nil, errCorruptRecord
+		}
+		data := payload[2+n : 2+n+int(size)]
+		payload = payload[2+n+int(size):]
+
+		msg, err := newMessage(kind)
+		if err != nil {
+			return nil, err
+		}
+		if err := proto.Unmarshal(data, msg); err != nil {
+			return nil, err
+		}
+		muts = append(muts, mutation{op: op, kind: kind, msg: msg})
+	}
+	return muts, nil
+}
+
+// readRecords calls fn for every record in r and returns the number of
+// bytes consumed by the records read. A record cut short by the end of r, or
+// one ending exactly there whose checksum is wrong, is what a crash during
+// an append leaves behind and is reported as errTornRecord. Damage anywhere
+// else, and a record that passes its checksum but cannot be decoded, is
+// reported as errCorruptRecord.
+func readRecords(r io.Reader, fn func(muts []mutation)) (int64, error) {
+	br := bufio.NewReader(r)
+	var offset int64
+	header := make([]byte, recordHeaderSize)
+	for {
+		if _, err := io.ReadFull(br, header); err != nil {
+			switch {
+			case errors.Is(err, io.EOF):
+				return offset, nil
+			case errors.Is(err, io.ErrUnexpectedEOF):
+				return offset, errTornRecord
+			}
+			return offset, err
+		}
+		size := binary.LittleEndian.Uint32(header[0:4])
+		sum := binary.LittleEndian.Uint32(header[4:8])
+		if size > maxRecordSize {
+			// A garbage length is only a torn append if nothing follows it
+			if _, err := br.Peek(1); errors.Is(err, io.EOF) {
+				return offset, errTornRecord
+			}
+			return offset, fmt.Errorf("%w at offset %d: length %d too large", errCorruptRecord, offset, size)
+		}
+		payload := make([]byte, size)
+		if _, err := io.ReadFull(br, payload); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return offset, errTornRecord
+			}
+			return offset, err
+		}
+		if crc32.Checksum(payload, crcTable) != sum {
+			if _, err := br.Peek(1); errors.Is(err, io.EOF) {
+				return offset, errTornRecord
+			}
+			return offset, fmt.Errorf("%w at offset %d: checksum mismatch", errCorruptRecord, offset)
+		}
+		// A record with a valid checksum was appended in full, so one that
+		// cannot be decoded, e.g. written by a newer version, is never torn
+		muts, err := decodeRecord(payload)
+		if err != nil {
+			return offset, fmt.Errorf("%w at offset %d: %v", errCorruptRecord, offset, err)
+		}
+		fn(muts)
+		offset += int64(recordHeaderSize) + int64(size)
+	}
+}
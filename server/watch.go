@@ -0,0 +1,166 @@
+package server
+
+import (
+	"fmt"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sync"
+	"time"
+)
+
+// DefaultWatchHistory is the number of past events kept for resuming
+// watchers unless configured otherwise
+const DefaultWatchHistory = 10000
+
+// The feed is kept in memory, so its events are lost when the server
+// restarts while the catalog itself survives in a durable store. Every
+// incarnation of the feed numbers its events after a base built from an
+// epoch shifted into the upper bits of the sequence: sequences keep
+// increasing across restarts, and a watcher resuming from a sequence of an
+// earlier incarnation is told to reload instead of silently missing or
+// replaying events. The epoch is the number of seconds the incarnation
+// started after feedEpochOrigin, raised by the store above the epoch of
+// the previous incarnation in case of quick restarts or a clock stepping
+// back.
+const feedEpochShift = 32
+
+// feedEpochOrigin is the time from which feed epochs are counted
+var feedEpochOrigin = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// feedEpoch returns the epoch given by the clock to an incarnation of the
+// feed starting at now
+func feedEpoch(now time.Time) int64 {
+	return int64(now.Sub(feedEpochOrigin) / time.Second)
+}
+
+// changeFeed is an in-memory log of the most recent book events. Watchers
+// read from the log at their own pace instead of having events pushed into
+// per-watcher buffers, so a slow watcher costs nothing until it falls out of
+// the retained history.
+type changeFeed struct {
+	mu      sync.Mutex
+	base    int64           // Sequence before the first event of this incarnation
+	seq     int64           // Sequence of the last event
+	history []*pb.BookEvent // Ring buffer of the last events
+	start   int             // Index of the oldest event in history
+	notify  chan struct{}   // Closed on the next publish or on close
+	closed  bool
+}
+
+// newChangeFeed creates an empty feed for the incarnation with the given
+// epoch that keeps the last size events, at least one
+func newChangeFeed(epoch int64, size int) *changeFeed {
+	base := epoch << feedEpochShift
+	return &changeFeed{
+		base:    base,
+		seq:     base,
+		history: make([]*pb.BookEvent, 0, max(size, 1)),
+		notify:  make(chan struct{}),
+	}
+}
+
+// publish assigns the next sequence to ev and wakes up the watchers.
+// Published events must not be modified.
+func (f *changeFeed) publish(ev *pb.BookEvent) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.seq++
+	ev.Sequence = f.seq
+	if len(f.history) < cap(f.history) {
+		f.history = append(f.history, ev)
+	} else {
+		f.history[f.start] = ev
+		f.start = (f.start + 1) % len(f.history)
+	}
+	close(f.notify)
+	f.notify = make(chan struct{})
+}
+
+// head returns the sequence of the last event
+func (f *changeFeed) head() int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.seq
+}
+
+// since returns the events after the given sequence, and a channel that is
+// closed when more events are published or the feed is closed
+func (f *changeFeed) since(after int64) ([]*pb.BookEvent, <-chan struct{}, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return nil, nil, status.Error(codes.Unavailable, "server is shutting down")
+	}
+	if after > f.seq {
+		return nil, nil, fieldError(codes.OutOfRange, "after_sequence",
+			fmt.Sprintf("sequence %d has not been reached, the last one is %d", after, f.seq))
+	}
+	if after < f.base {
+		return nil, nil, fieldError(codes.OutOfRange, "after_sequence",
+			fmt.Sprintf("sequence %d is from before the server restarted, reload the catalog and watch from %d", after, f.seq))
+	}
+	n := int(f.seq - after)
+	if n > len(f.history) {
+		return nil, nil, fieldError(codes.OutOfRange, "after_sequence",
+			fmt.Sprintf("events after sequence %d are no longer retained, reload the catalog and watch from %d", after, f.seq))
+	}
+	events := make([]*pb.BookEvent, n)
+	for i := range events {
+		events[i] = f.history[(f.start+len(f.history)-n+i)%len(f.history)]
+	}
+	return events, f.notify, nil
+}
+
+// close ends every watch
+func (f *changeFeed) close() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !f.closed {
+		f.closed = true
+		close(f.notify)
+	}
+}
+
+// WatchBooks implementation. Events are sent in sequence order; a watcher
+// that falls further behind than the retained history, or resumes from a
+// sequence that is no longer retained or was issued before the server
+// restarted, gets OutOfRange and should reload the catalog with ListBooks
+// before watching again.
+func (s *LibraryServer) WatchBooks(req *pb.WatchBooksRequest, stream grpc.ServerStreamingServer[pb.BookEvent]) error {
+	ctx := stream.Context()
+	after := req.AfterSequence
+	if after < 0 {
+		return fieldError(codes.InvalidArgument, "after_sequence", "must not be negative")
+	}
+	if after == 0 {
+		after = s.feed.head()
+	}
+
+	for {
+		events, wait, err := s.feed.since(after)
+		if err != nil {
+			return err
+		}
+		for _, ev := range events {
+			if err := stream.Send(ev); err != nil {
+				return err
+			}
+			after = ev.Sequence
+		}
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+}
+
+// Close ends the WatchBooks streams so that the gRPC server can stop
+// gracefully
+func (s *LibraryServer) Close() {
+	s.feed.close()
+}
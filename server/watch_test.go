@@ -0,0 +1,184 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	pb "github.com/Horizon-School-of-Digital-Technologies/library/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestChangeFeedRing(t *testing.T) {
+	f := newChangeFeed(feedEpoch(time.Now()), DefaultWatchHistory)
+	first := f.head()
+	for i := 0; i < DefaultWatchHistory+5; i++ {
+		f.publish(&pb.BookEvent{Type: pb.BookEvent_CREATED})
+	}
+	if got := f.head(); got != first+DefaultWatchHistory+5 {
+		t.Fatalf("head is %d, want %d", got, first+DefaultWatchHistory+5)
+	}
+
+	for _, after := range []int64{f.head(), f.head() - 1, f.head() - DefaultWatchHistory} {
+		events, _, err := f.since(after)
+		if err != nil {
+			t.Fatalf("since(%d): %v", after, err)
+		}
+		if len(events) != int(f.head()-after) {
+			t.Fatalf("since(%d) returned %d events, want %d", after, len(events), f.head()-after)
+		}
+		for i, ev := range events {
+			if ev.Sequence != after+int64(i)+1 {
+				t.Fatalf("since(%d)[%d] has sequence %d, want %d", after, i, ev.Sequence, after+int64(i)+1)
+			}
+		}
+	}
+
+	for _, after := range []int64{f.head() - DefaultWatchHistory - 1, f.head() + 1} {
+		if _, _, err := f.since(after); status.Code(err) != codes.OutOfRange {
+			t.Errorf("since(%d): got %v, want OutOfRange", after, err)
+		}
+	}
+}
+
+func TestChangeFeedRestart(t *testing.T) {
+	epoch := feedEpoch(time.Now())
+	before := newChangeFeed(epoch, DefaultWatchHistory)
+	for i := 0; i < 3; i++ {
+		before.publish(&pb.BookEvent{Type: pb.BookEvent_CREATED})
+	}
+
+	after := newChangeFeed(epoch+1, DefaultWatchHistory)
+	if after.head() <= before.head() {
+		t.Fatalf("sequences went back from %d to %d across a restart", before.head(), after.head())
+	}
+	for i := 0; i < 5; i++ {
+		after.publish(&pb.BookEvent{Type: pb.BookEvent_CREATED})
+	}
+	for _, seq := range []int64{before.head(), before.head() - 1} {
+		if _, _, err := after.since(seq); status.Code(err) != codes.OutOfRange {
+			t.Errorf("resuming from %d of the previous incarnation: got %v, want OutOfRange", seq, err)
+		}
+	}
+}
+
+func TestNextFeedEpoch(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	for _, tc := range []struct {
+		name string
+		open func() (Store, error)
+	}{
+		{"file", func() (Store, error) { return NewFileStore(filepath.Join(dir, "file"), 0) }},
+		{"sqlite", func() (Store, error) { return NewSQLStore("sqlite", filepath.Join(dir, "library.db")) }},
+	} {
+		// Restarts within one second, or after the clock stepped back, still
+		// get increasing epochs
+		var last int64
+		for _, now := range []int64{100, 100, 50, 200} {
+			s, err := tc.open()
+			if err != nil {
+				t.Fatal(err)
+			}
+			epoch, err := s.NextFeedEpoch(ctx, now)
+			s.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := max(now, last+1); epoch != want {
+				t.Errorf("%s: epoch %d after %d at time %d, want %d", tc.name, epoch, last, now, want)
+			}
+			last = epoch
+		}
+	}
+}
+
+func TestWatchEventsFollowCommits(t *testing.T) {
+	ctx := context.Background()
+	forEachStore(t, func(t *testing.T, store Store) {
+		s, err := NewLibraryServer(store, DefaultWatchHistory)
+		if err != nil {
+			t.Fatal(err)
+		}
+		emma, err := s.CreateBook(ctx, &pb.CreateBookRequest{Book: &pb.Book{Id: 2, Title: "Emma"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		start := s.feed.head()
+
+		// Book 1 is created and deleted over and over while another writer
+		// updates it, and two writers race on the updates of book 2
+		const rounds = 50
+		var writes atomic.Int64
+		write := func(err error) {
+			if err == nil {
+				writes.Add(1)
+			}
+		}
+		var wg sync.WaitGroup
+		wg.Add(4)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < rounds; i++ {
+				_, err := s.CreateBook(ctx, &pb.CreateBookRequest{Book: &pb.Book{Id: 1, Title: "Dune"}})
+				write(err)
+				_, err = s.DeleteBook(ctx, &pb.DeleteBookRequest{Id: 1})
+				write(err)
+			}
+		}()
+		for w := 0; w < 3; w++ {
+			id := int32(min(w+1, 2))
+			go func() {
+				defer wg.Done()
+				for i := 0; i < rounds; i++ {
+					title := fmt.Sprintf("writer %d update %d", w, i)
+					_, err := s.UpdateBook(ctx, &pb.UpdateBookRequest{Book: &pb.Book{Id: id, Title: title}})
+					write(err)
+				}
+			}()
+		}
+		wg.Wait()
+
+		events, _, err := s.feed.since(start)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if int64(len(events)) != writes.Load() {
+			t.Errorf("%d events for %d writes", len(events), writes.Load())
+		}
+
+		// Replaying the events in sequence order gives the stored books
+		books := map[int32]*pb.Book{2: emma.Book}
+		for _, ev := range events {
+			id := ev.GetAfter().GetId()
+			if ev.Before != nil {
+				id = ev.Before.Id
+			}
+			if prev := books[id]; !proto.Equal(prev, ev.Before) {
+				t.Fatalf("event %d of type %v on book %d: before %v, want %v", ev.Sequence, ev.Type, id, ev.Before, prev)
+			}
+			if ev.After == nil {
+				delete(books, id)
+			} else {
+				books[id] = ev.After
+			}
+		}
+		stored, err := store.ListBooks(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(stored) != len(books) {
+			t.Errorf("replay gives %d books, %d stored", len(books), len(stored))
+		}
+		for _, book := range stored {
+			if !proto.Equal(book, books[book.Id]) {
+				t.Errorf("replay gives %v, stored %v", books[book.Id], book)
+			}
+		}
+	})
+}